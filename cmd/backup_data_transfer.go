@@ -0,0 +1,110 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	veleroCmd "github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
+	"k8s.io/apimachinery/pkg/labels"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/migtools/oadp-cli/cmd/shared"
+)
+
+// addShowDataTransferFlag adds --show-data-transfer to Velero's "backup get", which then
+// follows the backup table with the data mover upload speed and nodes of each listed backup
+func addShowDataTransferFlag(backupCmd *cobra.Command, f client.Factory) {
+	getCmd, _, err := backupCmd.Find([]string{"get"})
+	if err != nil || getCmd == backupCmd {
+		return
+	}
+
+	var showDataTransfer bool
+	getCmd.Flags().BoolVar(&showDataTransfer, "show-data-transfer", false, "After the table, show the data mover upload speed of each backup and the nodes that handled its uploads")
+
+	veleroRun := getCmd.Run
+	getCmd.Run = func(c *cobra.Command, args []string) {
+		if showDataTransfer {
+			if format := output.GetOutputFlagValue(c); format != "" && format != "table" {
+				veleroCmd.CheckError(errors.New("--show-data-transfer is only supported with table output"))
+			}
+		}
+
+		veleroRun(c, args)
+		if !showDataTransfer {
+			return
+		}
+
+		kbClient, err := f.KubebuilderClient()
+		veleroCmd.CheckError(err)
+		selector, _ := c.Flags().GetString("selector")
+		veleroCmd.CheckError(printBackupDataTransfer(context.Background(), c.OutOrStdout(), kbClient, f.Namespace(), args, selector))
+	}
+}
+
+// printBackupDataTransfer prints the upload speed and upload nodes of the Velero backups in
+// the namespace, limited to the named backups or those matching the label selector when
+// given. Backups without DataUploads show "-".
+func printBackupDataTransfer(ctx context.Context, w io.Writer, kbClient kbclient.Client, namespace string, names []string, selector string) error {
+	opts := []kbclient.ListOption{kbclient.InNamespace(namespace)}
+	if selector != "" {
+		parsed, err := labels.Parse(selector)
+		if err != nil {
+			return fmt.Errorf("invalid --selector: %w", err)
+		}
+		opts = append(opts, kbclient.MatchingLabelsSelector{Selector: parsed})
+	}
+
+	var backups velerov1.BackupList
+	if err := kbClient.List(ctx, &backups, opts...); err != nil {
+		return fmt.Errorf("failed to list backups: %w", err)
+	}
+
+	transfers, err := shared.DataTransfers(ctx, kbClient, namespace, time.Now())
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "\n%-30s %-15s %s\n", "NAME", "TRANSFER-SPEED", "NODE")
+	for _, backup := range backups.Items {
+		if len(names) > 0 && !slices.Contains(names, backup.Name) {
+			continue
+		}
+		transfer := transfers[backup.Name]
+		speed, nodes := "-", "-"
+		if transfer.Speed != "" {
+			speed = transfer.Speed
+		}
+		if len(transfer.Nodes) > 0 {
+			nodes = strings.Join(transfer.Nodes, ",")
+		}
+		fmt.Fprintf(w, "%-30s %-15s %s\n", backup.Name, speed, nodes)
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	veleroshared "github.com/vmware-tanzu/velero/pkg/apis/velero/shared"
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli/backup"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/migtools/oadp-cli/cmd/shared"
+)
+
+// TestShowDataTransferFlag verifies the flag is added to the admin backup get command
+func TestShowDataTransferFlag(t *testing.T) {
+	backupCmd := backup.NewCommand(client.NewFactory("test", client.VeleroConfig{}))
+	addShowDataTransferFlag(backupCmd, nil)
+
+	getCmd, _, err := backupCmd.Find([]string{"get"})
+	if err != nil {
+		t.Fatalf("Find(get) error = %v", err)
+	}
+	if getCmd.Flags().Lookup("show-data-transfer") == nil {
+		t.Error("expected backup get to have a --show-data-transfer flag")
+	}
+}
+
+// TestPrintBackupDataTransfer verifies upload speeds and nodes are keyed by Velero backup name
+func TestPrintBackupDataTransfer(t *testing.T) {
+	scheme, err := shared.NewSchemeWithTypes(shared.ClientOptions{IncludeVeleroTypes: true})
+	if err != nil {
+		t.Fatalf("Failed to build scheme: %v", err)
+	}
+
+	start := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	newBackup := func(name string, labels map[string]string) *velerov1.Backup {
+		return &velerov1.Backup{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "openshift-adp", Labels: labels}}
+	}
+	objs := []kbclient.Object{
+		newBackup("datamover", map[string]string{"env": "prod"}),
+		newBackup("fs-backup", map[string]string{"env": "prod"}),
+		newBackup("other", map[string]string{"env": "dev"}),
+		&velerov2alpha1.DataUpload{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "datamover-upload",
+				Namespace: "openshift-adp",
+				Labels:    map[string]string{velerov1.BackupNameLabel: "datamover"},
+			},
+			Status: velerov2alpha1.DataUploadStatus{
+				Phase:               velerov2alpha1.DataUploadPhaseCompleted,
+				StartTimestamp:      &metav1.Time{Time: start},
+				CompletionTimestamp: &metav1.Time{Time: start.Add(100 * time.Second)},
+				Progress:            veleroshared.DataMoveOperationProgress{TotalBytes: 1 << 30, BytesDone: 1 << 30},
+				Node:                "worker-1",
+			},
+		},
+	}
+	kbClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+
+	var out bytes.Buffer
+	if err := printBackupDataTransfer(context.Background(), &out, kbClient, "openshift-adp", nil, "env=prod"); err != nil {
+		t.Fatalf("printBackupDataTransfer() error = %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	want := [][]string{
+		{"NAME", "TRANSFER-SPEED", "NODE"},
+		{"datamover", "10.2", "MiB/s", "worker-1"},
+		{"fs-backup", "-", "-"},
+	}
+	if len(lines) != len(want) {
+		t.Fatalf("expected %d lines, got:\n%s", len(want), out.String())
+	}
+	for i, fields := range want {
+		if got := strings.Fields(lines[i]); strings.Join(got, " ") != strings.Join(fields, " ") {
+			t.Errorf("line %d = %q, want %q", i, lines[i], strings.Join(fields, " "))
+		}
+	}
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+)
+
+// pluginBinaryName is the executable name kubectl looks up for "kubectl oadp"
+const pluginBinaryName = "kubectl-oadp"
+
+// completionShells lists the shells the completion command can generate scripts for
+var completionShells = []string{"bash", "zsh", "fish", "powershell"}
+
+// newCompletionCommand creates the "completion" subcommand that prints shell completion scripts
+func newCompletionCommand(usagePrefix string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "completion SHELL",
+		Short: "Output shell completion code for the specified shell (bash, zsh, fish or powershell)",
+		Long: fmt.Sprintf(`Output shell completion code for the specified shell (bash, zsh, fish or powershell).
+
+When installed as a kubectl plugin, the script completes the %[1]s executable. To complete
+"kubectl oadp" as well (kubectl 1.26+), put an executable named kubectl_complete-oadp on
+your PATH that runs: %[1]s __complete "$@"`, pluginBinaryName),
+		Example: fmt.Sprintf(`  # Load completions into the current bash session
+  source <(%[1]s completion bash)
+
+  # Load completions into the current zsh session
+  source <(%[1]s completion zsh)
+
+  # Load fish completions
+  %[1]s completion fish | source`, usagePrefix),
+		Args:                  cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+		ValidArgs:             completionShells,
+		DisableFlagsInUseLine: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			name := "oadp"
+			if isRunningAsPlugin() {
+				name = pluginBinaryName
+			}
+			return generateCompletion(c.Root(), args[0], name, c.OutOrStdout())
+		},
+	}
+}
+
+// generateCompletion writes the completion script for shell to w. The scripts register
+// completions for the root command's name, so it is set to the executable name first.
+func generateCompletion(root *cobra.Command, shell, name string, w io.Writer) error {
+	use := root.Use
+	root.Use = name
+	defer func() { root.Use = use }()
+
+	switch shell {
+	case "bash":
+		return root.GenBashCompletionV2(w, true)
+	case "zsh":
+		return root.GenZshCompletion(w)
+	case "fish":
+		return root.GenFishCompletion(w, true)
+	case "powershell":
+		return root.GenPowerShellCompletionWithDesc(w)
+	default:
+		return fmt.Errorf("unsupported shell %q, must be one of %v", shell, completionShells)
+	}
+}
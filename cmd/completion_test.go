@@ -0,0 +1,57 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// TestGenerateCompletion verifies every supported shell produces a script registered
+// for the given executable name
+func TestGenerateCompletion(t *testing.T) {
+	root := NewVeleroRootCommand()
+
+	for _, shell := range completionShells {
+		for _, name := range []string{"oadp", pluginBinaryName} {
+			t.Run(shell+"/"+name, func(t *testing.T) {
+				var out bytes.Buffer
+				if err := generateCompletion(root, shell, name, &out); err != nil {
+					t.Fatalf("generateCompletion() error = %v", err)
+				}
+				if out.Len() == 0 {
+					t.Fatal("expected a non-empty completion script")
+				}
+				if !strings.Contains(out.String(), name) {
+					t.Errorf("expected the %s script to reference %q", shell, name)
+				}
+				if root.Use != "oadp" {
+					t.Errorf("expected the root command name to be restored, got %q", root.Use)
+				}
+			})
+		}
+	}
+}
+
+// TestGenerateCompletionUnsupportedShell verifies an unknown shell is rejected
+func TestGenerateCompletionUnsupportedShell(t *testing.T) {
+	var out bytes.Buffer
+	if err := generateCompletion(NewVeleroRootCommand(), "tcsh", "oadp", &out); err == nil {
+		t.Fatal("expected an error for an unsupported shell")
+	}
+}
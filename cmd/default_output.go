@@ -0,0 +1,54 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/migtools/oadp-cli/cmd/shared"
+)
+
+// applyDefaultOutput makes format the -o default of every get command under root that
+// accepts it, so an explicit -o still wins, and reports whether any did. Get commands that
+// don't accept format keep their default rather than failing, e.g. Velero's get commands
+// with -o wide.
+//
+// Only get commands are covered. Create commands only print the object instead of
+// creating it with -o, and describe commands only understand go-template output.
+func applyDefaultOutput(root *cobra.Command, format string) bool {
+	if format == "" {
+		return false
+	}
+
+	applied := false
+	for _, c := range root.Commands() {
+		if applyDefaultOutput(c, format) {
+			applied = true
+		}
+
+		if c.Name() != "get" || !shared.AcceptsOutputFormat(c, format) {
+			continue
+		}
+		if f := c.Flags().Lookup("output"); f != nil {
+			if err := f.Value.Set(format); err == nil {
+				f.DefValue = format
+				applied = true
+			}
+		}
+	}
+	return applied
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
+)
+
+// TestApplyDefaultOutput verifies default-output on the real command tree becomes the -o
+// default of the get commands that accept it, leaving the others and create and describe
+// commands alone
+func TestApplyDefaultOutput(t *testing.T) {
+	// Keep any client config on the machine running the tests out of the tree
+	t.Setenv("HOME", t.TempDir())
+
+	tests := []struct {
+		name          string
+		format        string
+		expectApplied bool
+		expect        map[string]string
+	}{
+		{
+			name:          "yaml",
+			format:        "yaml",
+			expectApplied: true,
+			expect: map[string]string{
+				"nonadmin backup get":      "yaml",
+				"backup get":               "yaml",
+				"nabsl-request get":        "yaml",
+				"nonadmin backup create":   "",
+				"nonadmin backup describe": "",
+			},
+		},
+		{
+			name:          "wide is only accepted by nonadmin backup get",
+			format:        "wide",
+			expectApplied: true,
+			expect: map[string]string{
+				"nonadmin backup get": "wide",
+				"backup get":          "table",
+				"nabsl-request get":   "",
+			},
+		},
+		{
+			name:          "table is not accepted by nabsl-request get",
+			format:        "table",
+			expectApplied: true,
+			expect: map[string]string{
+				"nonadmin backup get": "table",
+				"backup get":          "table",
+				"nabsl-request get":   "",
+			},
+		},
+		{
+			name:   "typo",
+			format: "ymal",
+			expect: map[string]string{
+				"nonadmin backup get": "",
+				"backup get":          "table",
+				"nabsl-request get":   "",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := NewVeleroRootCommand()
+			if applied := applyDefaultOutput(root, tt.format); applied != tt.expectApplied {
+				t.Errorf("applyDefaultOutput() = %v, want %v", applied, tt.expectApplied)
+			}
+
+			for path, want := range tt.expect {
+				c, _, err := root.Find(strings.Fields(path))
+				if err != nil {
+					t.Fatalf("Find(%q) error = %v", path, err)
+				}
+				if got := output.GetOutputFlagValue(c); got != want {
+					t.Errorf("%s -o default = %q, want %q", path, got, want)
+				}
+			}
+
+			// Velero's get commands validate -o before running, so a default they don't
+			// accept would break them
+			c, _, err := root.Find([]string{"backup", "get"})
+			if err != nil {
+				t.Fatalf("Find() error = %v", err)
+			}
+			if err := output.ValidateFlags(c); err != nil {
+				t.Errorf("ValidateFlags(backup get) error = %v", err)
+			}
+		})
+	}
+}
+
+// TestApplyDefaultOutputExplicitFlag verifies an explicit -o still wins over default-output
+func TestApplyDefaultOutputExplicitFlag(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	root := NewVeleroRootCommand()
+	applyDefaultOutput(root, "json")
+
+	c, _, err := root.Find([]string{"nonadmin", "backup", "get"})
+	if err != nil {
+		t.Fatalf("Find() error = %v", err)
+	}
+	if err := c.ParseFlags([]string{"-o", "yaml"}); err != nil {
+		t.Fatalf("ParseFlags() error = %v", err)
+	}
+	if got := output.GetOutputFlagValue(c); got != "yaml" {
+		t.Errorf("-o = %q, want the explicit yaml", got)
+	}
+}
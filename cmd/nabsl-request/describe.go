@@ -17,7 +17,6 @@ limitations under the License.
 package nabsl
 
 import (
-	"context"
 	"fmt"
 	"sort"
 	"strings"
@@ -82,18 +81,18 @@ func (o *DescribeOptions) Validate(c *cobra.Command, args []string, f client.Fac
 }
 
 func (o *DescribeOptions) Run(c *cobra.Command, f client.Factory) error {
-	// Get the admin namespace (from client config) where requests are stored
-	adminNS := f.Namespace()
+	ctx, cancel := shared.RequestContext()
+	defer cancel()
 
-	// Get the current namespace to find user's NABSLs
-	currentNS, err := shared.GetCurrentNamespace()
+	// The admin namespace holds the requests; the current namespace holds the user's NABSLs
+	adminNS, currentNS, err := resolveNamespaces(c)
 	if err != nil {
-		return fmt.Errorf("failed to determine current namespace: %w", err)
+		return err
 	}
 
 	// First get all NABSLs in user's namespace to find related requests
 	var nabslList nacv1alpha1.NonAdminBackupStorageLocationList
-	err = o.client.List(context.Background(), &nabslList, kbclient.InNamespace(currentNS))
+	err = o.client.List(ctx, &nabslList, kbclient.InNamespace(currentNS))
 	if err != nil {
 		return fmt.Errorf("failed to list NABSLs: %w", err)
 	}
@@ -117,7 +116,7 @@ func (o *DescribeOptions) Run(c *cobra.Command, f client.Factory) error {
 
 	// Get the request from openshift-adp namespace using the UUID
 	var request nacv1alpha1.NonAdminBackupStorageLocationRequest
-	err = o.client.Get(context.Background(), kbclient.ObjectKey{
+	err = o.client.Get(ctx, kbclient.ObjectKey{
 		Name:      targetUUID,
 		Namespace: adminNS,
 	}, &request)
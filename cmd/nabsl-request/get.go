@@ -19,11 +19,15 @@ package nabsl
 import (
 	"context"
 	"fmt"
-	"os"
+	"io"
+	"sort"
+	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/migtools/oadp-cli/cmd/shared"
@@ -41,6 +45,8 @@ func NewGetCommand(f client.Factory) *cobra.Command {
 		Use:   "get [NAME]",
 		Short: "Get non-admin backup storage location requests",
 		Args:  cobra.MaximumNArgs(1),
+		// Velero's table printer doesn't know the NAC types, so only json and yaml work
+		Annotations: map[string]string{shared.OutputFormatsAnnotation: "json,yaml"},
 		Run: func(c *cobra.Command, args []string) {
 			cmd.CheckError(o.Complete(args, f))
 			cmd.CheckError(o.Validate(c, args, f))
@@ -56,7 +62,16 @@ func NewGetCommand(f client.Factory) *cobra.Command {
   kubectl oadp nabsl-request get nacuser01-my-bsl-96dfa8b7-3f6f-4c8d-a168-8527b00fbed8
 
   # Get output in YAML format
-  kubectl oadp nabsl-request get my-bsl-request -o yaml`,
+  kubectl oadp nabsl-request get my-bsl-request -o yaml
+
+  # List requests without the header row
+  kubectl oadp nabsl-request get --no-headers
+
+  # List only the requests waiting for a decision
+  kubectl oadp nabsl-request get --phase pending
+
+  # List the requests created in the last 24 hours
+  kubectl oadp nabsl-request get --newer-than 24h`,
 	}
 
 	o.BindFlags(c.Flags())
@@ -69,15 +84,29 @@ func NewGetCommand(f client.Factory) *cobra.Command {
 type GetOptions struct {
 	Name          string
 	AllNamespaces bool
+	NoHeaders     bool
+	Phase         string
+	NewerThan     time.Duration
+	phase         nacv1alpha1.NonAdminBSLRequestPhase
 	client        kbclient.WithWatch
 }
 
+// requestPhases are the phases --phase accepts
+var requestPhases = []nacv1alpha1.NonAdminBSLRequestPhase{
+	nacv1alpha1.NonAdminBSLRequestPhasePending,
+	nacv1alpha1.NonAdminBSLRequestPhaseApproved,
+	nacv1alpha1.NonAdminBSLRequestPhaseRejected,
+}
+
 func NewGetOptions() *GetOptions {
 	return &GetOptions{}
 }
 
 func (o *GetOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.BoolVar(&o.AllNamespaces, "all-namespaces", false, "If present, list requests across all namespaces")
+	flags.BoolVar(&o.NoHeaders, "no-headers", false, "When using the default output format, don't print headers")
+	shared.BindNewerThanFlag(flags, &o.NewerThan)
+	flags.StringVar(&o.Phase, "phase", "", "Only show requests in this phase: Pending, Approved or Rejected (case-insensitive)")
 }
 
 func (o *GetOptions) Complete(args []string, f client.Factory) error {
@@ -98,60 +127,42 @@ func (o *GetOptions) Complete(args []string, f client.Factory) error {
 }
 
 func (o *GetOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
-	return nil
+	if o.Phase == "" {
+		return nil
+	}
+	for _, phase := range requestPhases {
+		if strings.EqualFold(o.Phase, string(phase)) {
+			o.phase = phase
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown --phase %q, must be one of Pending, Approved or Rejected", o.Phase)
 }
 
 func (o *GetOptions) Run(c *cobra.Command, f client.Factory) error {
-	// Get the admin namespace (from client config) where requests are stored
-	adminNS := f.Namespace()
+	ctx, cancel := shared.RequestContext()
+	defer cancel()
 
-	// Get the current namespace to find user's NABSLs
-	currentNS, err := shared.GetCurrentNamespace()
+	// The admin namespace holds the requests; the current namespace holds the user's NABSLs
+	adminNS, currentNS, err := resolveNamespaces(c)
 	if err != nil {
-		return fmt.Errorf("failed to determine current namespace: %w", err)
+		return err
 	}
 
-	// First get all NABSLs in user's namespace to find related requests
-	var nabslList nacv1alpha1.NonAdminBackupStorageLocationList
-	err = o.client.List(context.Background(), &nabslList, kbclient.InNamespace(currentNS))
+	userRequests, err := findUserRequests(ctx, o.client, adminNS, currentNS)
 	if err != nil {
-		return fmt.Errorf("failed to list NABSLs: %w", err)
+		return err
 	}
-
-	// Collect request UUIDs from NABSL statuses
-	requestUUIDs := make(map[string]string) // UUID -> NABSL name
-	for _, nabsl := range nabslList.Items {
-		if nabsl.Status.VeleroBackupStorageLocation != nil && nabsl.Status.VeleroBackupStorageLocation.NACUUID != "" {
-			requestUUIDs[nabsl.Status.VeleroBackupStorageLocation.NACUUID] = nabsl.Name
-		}
+	if o.phase != "" {
+		userRequests = filterRequestsByPhase(userRequests, o.phase)
 	}
+	userRequests = shared.FilterNewerThan(userRequests, o.NewerThan, time.Now())
 
 	if o.Name != "" {
 		// Get specific request by UUID or NABSL name
-		var targetUUID string
-
-		// Check if o.Name is a UUID or NABSL name
-		if _, exists := requestUUIDs[o.Name]; exists {
-			// o.Name is a UUID
-			targetUUID = o.Name
-		} else {
-			// o.Name might be a NABSL name, find its UUID
-			for uuid, nabslName := range requestUUIDs {
-				if nabslName == o.Name {
-					targetUUID = uuid
-					break
-				}
-			}
-		}
-
-		if targetUUID != "" {
-			var request nacv1alpha1.NonAdminBackupStorageLocationRequest
-			err := o.client.Get(context.Background(), kbclient.ObjectKey{
-				Name:      targetUUID,
-				Namespace: adminNS,
-			}, &request)
-			if err != nil {
-				return fmt.Errorf("failed to get request for %q: %w", o.Name, err)
+		for _, request := range userRequests {
+			if request.Name != o.Name && (request.Status.SourceNonAdminBSL == nil || request.Status.SourceNonAdminBSL.Name != o.Name) {
+				continue
 			}
 
 			if printed, err := output.PrintWithFormat(c, &request); printed || err != nil {
@@ -161,44 +172,94 @@ func (o *GetOptions) Run(c *cobra.Command, f client.Factory) error {
 			list := &nacv1alpha1.NonAdminBackupStorageLocationRequestList{
 				Items: []nacv1alpha1.NonAdminBackupStorageLocationRequest{request},
 			}
-			return printRequestTable(list)
+			return printRequestTable(c.OutOrStdout(), list, o.NoHeaders)
 		}
 
 		return fmt.Errorf("request %q not found for NABSLs in namespace %s", o.Name, currentNS)
 	}
 
-	// List all requests related to user's NABSLs
-	var userRequests []nacv1alpha1.NonAdminBackupStorageLocationRequest
-	for uuid := range requestUUIDs {
+	requestList := &nacv1alpha1.NonAdminBackupStorageLocationRequestList{
+		Items: userRequests,
+	}
+
+	if printed, err := output.PrintWithFormat(c, requestList); printed || err != nil {
+		return err
+	}
+
+	return printRequestTable(c.OutOrStdout(), requestList, o.NoHeaders)
+}
+
+// findUserRequests returns the requests in adminNS for the NABSLs in userNS, sorted by name.
+// Requests are found through the UUID in each NABSL status and, since NABSLs pending
+// reconciliation don't have a UUID yet, through the source NABSL recorded on the request.
+func findUserRequests(ctx context.Context, kbClient kbclient.Client, adminNS, userNS string) ([]nacv1alpha1.NonAdminBackupStorageLocationRequest, error) {
+	// First get all NABSLs in user's namespace to find related requests
+	var nabslList nacv1alpha1.NonAdminBackupStorageLocationList
+	if err := kbClient.List(ctx, &nabslList, kbclient.InNamespace(userNS)); err != nil {
+		return nil, fmt.Errorf("failed to list NABSLs: %w", err)
+	}
+
+	// Requests are named by UUID, so merging by name avoids duplicates
+	requests := make(map[string]nacv1alpha1.NonAdminBackupStorageLocationRequest)
+	for _, nabsl := range nabslList.Items {
+		if nabsl.Status.VeleroBackupStorageLocation == nil || nabsl.Status.VeleroBackupStorageLocation.NACUUID == "" {
+			continue
+		}
+
 		var request nacv1alpha1.NonAdminBackupStorageLocationRequest
-		err := o.client.Get(context.Background(), kbclient.ObjectKey{
-			Name:      uuid,
+		if err := kbClient.Get(ctx, kbclient.ObjectKey{
+			Name:      nabsl.Status.VeleroBackupStorageLocation.NACUUID,
 			Namespace: adminNS,
-		}, &request)
-		if err != nil {
+		}, &request); err != nil {
 			// Request might not exist yet, skip
 			continue
 		}
-		userRequests = append(userRequests, request)
+		requests[request.Name] = request
 	}
 
-	requestList := &nacv1alpha1.NonAdminBackupStorageLocationRequestList{
-		Items: userRequests,
+	var requestList nacv1alpha1.NonAdminBackupStorageLocationRequestList
+	if err := kbClient.List(ctx, &requestList, kbclient.InNamespace(adminNS)); err != nil {
+		// Listing may not be allowed; the requests found by UUID are still valid
+		if !apierrors.IsForbidden(err) {
+			return nil, fmt.Errorf("failed to list requests: %w", err)
+		}
+	}
+	for _, request := range requestList.Items {
+		if request.Status.SourceNonAdminBSL != nil && request.Status.SourceNonAdminBSL.Namespace == userNS {
+			requests[request.Name] = request
+		}
 	}
 
-	if printed, err := output.PrintWithFormat(c, requestList); printed || err != nil {
-		return err
+	result := make([]nacv1alpha1.NonAdminBackupStorageLocationRequest, 0, len(requests))
+	for _, request := range requests {
+		result = append(result, request)
 	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result, nil
+}
 
-	return printRequestTable(requestList)
+// filterRequestsByPhase returns the requests in the given phase
+func filterRequestsByPhase(requests []nacv1alpha1.NonAdminBackupStorageLocationRequest, phase nacv1alpha1.NonAdminBSLRequestPhase) []nacv1alpha1.NonAdminBackupStorageLocationRequest {
+	filtered := make([]nacv1alpha1.NonAdminBackupStorageLocationRequest, 0, len(requests))
+	for _, request := range requests {
+		if request.Status.Phase == phase {
+			filtered = append(filtered, request)
+		}
+	}
+	return filtered
 }
 
-func printRequestTable(requestList *nacv1alpha1.NonAdminBackupStorageLocationRequestList) error {
-	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+func printRequestTable(out io.Writer, requestList *nacv1alpha1.NonAdminBackupStorageLocationRequestList, noHeaders bool) error {
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
 	defer w.Flush()
 
 	// Print header
-	fmt.Fprintln(w, "NAME\tNAMESPACE\tPHASE\tREQUESTED-NABSL\tREQUESTED-NAMESPACE\tAGE")
+	if !noHeaders {
+		fmt.Fprintln(w, "NAME\tNAMESPACE\tPHASE\tREQUESTED-NABSL\tREQUESTED-NAMESPACE\tAGE")
+	}
 
 	for _, request := range requestList.Items {
 		age := metav1.Now().Sub(request.CreationTimestamp.Time)
@@ -0,0 +1,139 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nabsl
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/migtools/oadp-cli/cmd/shared"
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+)
+
+// TestPrintRequestTableNoHeaders verifies --no-headers suppresses only the header row
+func TestPrintRequestTableNoHeaders(t *testing.T) {
+	list := &nacv1alpha1.NonAdminBackupStorageLocationRequestList{
+		Items: []nacv1alpha1.NonAdminBackupStorageLocationRequest{{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-request", Namespace: "openshift-adp", CreationTimestamp: metav1.Now()},
+			Status:     nacv1alpha1.NonAdminBackupStorageLocationRequestStatus{Phase: nacv1alpha1.NonAdminBSLRequestPhasePending},
+		}},
+	}
+
+	for _, noHeaders := range []bool{false, true} {
+		var out bytes.Buffer
+		if err := printRequestTable(&out, list, noHeaders); err != nil {
+			t.Fatalf("printRequestTable() error = %v", err)
+		}
+
+		lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+		hasHeader := strings.HasPrefix(lines[0], "NAME")
+		if hasHeader == noHeaders {
+			t.Errorf("noHeaders=%v: header present = %v, output:\n%s", noHeaders, hasHeader, out.String())
+		}
+		if !strings.HasPrefix(lines[len(lines)-1], "my-request") {
+			t.Errorf("noHeaders=%v: expected request row, output:\n%s", noHeaders, out.String())
+		}
+	}
+}
+
+// TestFindUserRequests verifies requests are found both through the NABSL status UUID
+// and, for NABSLs not yet reconciled, through the request's source NABSL namespace
+func TestFindUserRequests(t *testing.T) {
+	scheme, err := shared.NewSchemeWithTypes(shared.ClientOptions{IncludeNonAdminTypes: true})
+	if err != nil {
+		t.Fatalf("Failed to build scheme: %v", err)
+	}
+
+	newRequest := func(uuid, nabslName, nabslNamespace string) *nacv1alpha1.NonAdminBackupStorageLocationRequest {
+		return &nacv1alpha1.NonAdminBackupStorageLocationRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: uuid, Namespace: "openshift-adp"},
+			Status: nacv1alpha1.NonAdminBackupStorageLocationRequestStatus{
+				SourceNonAdminBSL: &nacv1alpha1.SourceNonAdminBSL{Name: nabslName, Namespace: nabslNamespace},
+			},
+		}
+	}
+
+	reconciled := &nacv1alpha1.NonAdminBackupStorageLocation{
+		ObjectMeta: metav1.ObjectMeta{Name: "reconciled-bsl", Namespace: "my-project"},
+		Status: nacv1alpha1.NonAdminBackupStorageLocationStatus{
+			VeleroBackupStorageLocation: &nacv1alpha1.VeleroBackupStorageLocation{NACUUID: "uuid-reconciled"},
+		},
+	}
+	pending := &nacv1alpha1.NonAdminBackupStorageLocation{
+		ObjectMeta: metav1.ObjectMeta{Name: "pending-bsl", Namespace: "my-project"},
+	}
+
+	kbClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		reconciled,
+		pending,
+		newRequest("uuid-reconciled", "reconciled-bsl", "my-project"),
+		newRequest("uuid-pending", "pending-bsl", "my-project"),
+		newRequest("uuid-other", "other-bsl", "other-project"),
+	).Build()
+
+	requests, err := findUserRequests(context.Background(), kbClient, "openshift-adp", "my-project")
+	if err != nil {
+		t.Fatalf("findUserRequests() error = %v", err)
+	}
+
+	var names []string
+	for _, request := range requests {
+		names = append(names, request.Name)
+	}
+	if got, want := strings.Join(names, ","), "uuid-pending,uuid-reconciled"; got != want {
+		t.Errorf("findUserRequests() = %s, want %s", got, want)
+	}
+}
+
+// TestGetPhaseFilter verifies --phase narrows a mixed list to one phase and rejects unknown phases
+func TestGetPhaseFilter(t *testing.T) {
+	newRequest := func(name string, phase nacv1alpha1.NonAdminBSLRequestPhase) nacv1alpha1.NonAdminBackupStorageLocationRequest {
+		return nacv1alpha1.NonAdminBackupStorageLocationRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "openshift-adp"},
+			Status:     nacv1alpha1.NonAdminBackupStorageLocationRequestStatus{Phase: phase},
+		}
+	}
+	requests := []nacv1alpha1.NonAdminBackupStorageLocationRequest{
+		newRequest("approved", nacv1alpha1.NonAdminBSLRequestPhaseApproved),
+		newRequest("pending-1", nacv1alpha1.NonAdminBSLRequestPhasePending),
+		newRequest("rejected", nacv1alpha1.NonAdminBSLRequestPhaseRejected),
+		newRequest("pending-2", nacv1alpha1.NonAdminBSLRequestPhasePending),
+	}
+
+	o := &GetOptions{Phase: "pending"}
+	if err := o.Validate(nil, nil, nil); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+
+	var names []string
+	for _, request := range filterRequestsByPhase(requests, o.phase) {
+		names = append(names, request.Name)
+	}
+	if got := strings.Join(names, ","); got != "pending-1,pending-2" {
+		t.Errorf("expected only the pending requests, got %q", got)
+	}
+
+	o = &GetOptions{Phase: "waiting"}
+	if err := o.Validate(nil, nil, nil); err == nil || !strings.Contains(err.Error(), `unknown --phase "waiting"`) {
+		t.Errorf("Validate() error = %v, want an unknown phase error", err)
+	}
+}
@@ -17,8 +17,12 @@ limitations under the License.
 package nabsl
 
 import (
+	"fmt"
+
 	"github.com/spf13/cobra"
 	"github.com/vmware-tanzu/velero/pkg/client"
+
+	"github.com/migtools/oadp-cli/cmd/shared"
 )
 
 // NewNABSLRequestCommand creates the "nabsl-request" command for managing non-admin backup storage location requests
@@ -42,9 +46,20 @@ Use these commands to view, approve, or reject pending NABSL requests.`,
   kubectl oadp nabsl-request approve my-storage-request
 
   # Reject a NABSL approval request  
-  kubectl oadp nabsl-request reject my-storage-request`,
+  kubectl oadp nabsl-request reject my-storage-request
+
+  # List requests on another cluster
+  kubectl oadp nabsl-request get --context prod
+
+  # Check what a user is allowed to see, impersonating them on the prod cluster
+  kubectl oadp nabsl-request get --kubecontext prod --as alice --as-group developers`,
 	}
 
+	// --kubeconfig, --kubecontext and -n select the cluster and admin namespace
+	f.BindFlags(c.PersistentFlags())
+	shared.BindRequestTimeoutFlag(c.PersistentFlags())
+	shared.BindImpersonationFlags(c.PersistentFlags())
+
 	c.AddCommand(
 		NewGetCommand(f),
 		NewDescribeCommand(f),
@@ -54,3 +69,26 @@ Use these commands to view, approve, or reject pending NABSL requests.`,
 
 	return c
 }
+
+// resolveNamespaces returns the admin namespace requests are stored in and the user's
+// current namespace. Both come from the kubeconfig and context selected on the command
+// line, so --context targets the same cluster for each. The admin namespace is -n if set,
+// then the client config namespace, then the selected context's namespace.
+func resolveNamespaces(c *cobra.Command) (adminNS, currentNS string, err error) {
+	kubeconfig, _ := c.Flags().GetString("kubeconfig")
+	kubecontext, _ := c.Flags().GetString("kubecontext")
+
+	currentNS, err = shared.GetContextNamespace(kubeconfig, kubecontext)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to determine current namespace: %w", err)
+	}
+
+	if c.Flags().Changed("namespace") {
+		adminNS, _ = c.Flags().GetString("namespace")
+		return adminNS, currentNS, nil
+	}
+	if clientConfig, err := shared.ReadVeleroClientConfig(); err == nil && clientConfig.Namespace != "" {
+		return clientConfig.Namespace, currentNS, nil
+	}
+	return currentNS, currentNS, nil
+}
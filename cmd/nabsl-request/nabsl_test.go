@@ -17,8 +17,12 @@ limitations under the License.
 package nabsl
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/vmware-tanzu/velero/pkg/client"
+
 	"github.com/migtools/oadp-cli/internal/testutil"
 )
 
@@ -140,3 +144,119 @@ func TestNABSLClientConfigIntegration(t *testing.T) {
 		}
 	})
 }
+
+// TestResolveNamespacesFromContext verifies that switching contexts resolves both the admin
+// and current namespaces from the selected context, which the factory also connects to
+func TestResolveNamespacesFromContext(t *testing.T) {
+	kubeconfig := filepath.Join(t.TempDir(), "config")
+	if err := os.WriteFile(kubeconfig, []byte(`apiVersion: v1
+kind: Config
+current-context: dev
+clusters:
+- name: dev
+  cluster:
+    server: https://dev.example.com
+- name: prod
+  cluster:
+    server: https://prod.example.com
+users:
+- name: user
+contexts:
+- name: dev
+  context:
+    cluster: dev
+    user: user
+    namespace: dev-project
+- name: prod
+  context:
+    cluster: prod
+    user: user
+    namespace: prod-project
+`), 0600); err != nil {
+		t.Fatalf("Failed to write kubeconfig: %v", err)
+	}
+
+	tests := []struct {
+		name            string
+		args            []string
+		clientConfigNS  string
+		expectAdminNS   string
+		expectCurrentNS string
+		expectServer    string
+	}{
+		{
+			name:            "current context",
+			args:            []string{"--kubeconfig", kubeconfig, "-n", "openshift-adp"},
+			expectAdminNS:   "openshift-adp",
+			expectCurrentNS: "dev-project",
+			expectServer:    "https://dev.example.com",
+		},
+		{
+			name:            "client config namespace without -n",
+			args:            []string{"--kubeconfig", kubeconfig, "--kubecontext", "prod"},
+			clientConfigNS:  "openshift-adp",
+			expectAdminNS:   "openshift-adp",
+			expectCurrentNS: "prod-project",
+			expectServer:    "https://prod.example.com",
+		},
+		{
+			name:            "context namespace without -n",
+			args:            []string{"--kubeconfig", kubeconfig, "--kubecontext", "prod"},
+			expectAdminNS:   "prod-project",
+			expectCurrentNS: "prod-project",
+			expectServer:    "https://prod.example.com",
+		},
+		{
+			name:            "--kubecontext",
+			args:            []string{"--kubeconfig", kubeconfig, "--kubecontext", "prod", "-n", "prod-adp"},
+			expectAdminNS:   "prod-adp",
+			expectCurrentNS: "prod-project",
+			expectServer:    "https://prod.example.com",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Only the case's client config, not the one on the machine running the tests
+			home := t.TempDir()
+			t.Setenv("HOME", home)
+			if tt.clientConfigNS != "" {
+				configDir := filepath.Join(home, ".config", "velero")
+				if err := os.MkdirAll(configDir, 0755); err != nil {
+					t.Fatalf("Failed to create client config dir: %v", err)
+				}
+				if err := os.WriteFile(filepath.Join(configDir, "config.json"), []byte(`{"namespace":"`+tt.clientConfigNS+`"}`), 0600); err != nil {
+					t.Fatalf("Failed to write client config: %v", err)
+				}
+			}
+
+			f := client.NewFactory("test", client.VeleroConfig{})
+			get, _, err := NewNABSLRequestCommand(f).Find([]string{"get"})
+			if err != nil {
+				t.Fatalf("Failed to find get command: %v", err)
+			}
+			if err := get.ParseFlags(tt.args); err != nil {
+				t.Fatalf("ParseFlags() error = %v", err)
+			}
+
+			adminNS, currentNS, err := resolveNamespaces(get)
+			if err != nil {
+				t.Fatalf("resolveNamespaces() error = %v", err)
+			}
+			if adminNS != tt.expectAdminNS {
+				t.Errorf("admin namespace = %q, want %q", adminNS, tt.expectAdminNS)
+			}
+			if currentNS != tt.expectCurrentNS {
+				t.Errorf("current namespace = %q, want %q", currentNS, tt.expectCurrentNS)
+			}
+
+			config, err := f.ClientConfig()
+			if err != nil {
+				t.Fatalf("ClientConfig() error = %v", err)
+			}
+			if config.Host != tt.expectServer {
+				t.Errorf("factory server = %q, want %q", config.Host, tt.expectServer)
+			}
+		})
+	}
+}
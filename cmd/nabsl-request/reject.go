@@ -17,7 +17,6 @@ limitations under the License.
 package nabsl
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/spf13/cobra"
@@ -30,7 +29,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/cmd"
 )
 
-// NewRejectCommand creates the "deny" subcommand under bsl request
+// NewRejectCommand creates the "deny" subcommand under nabsl-request
 func NewRejectCommand(f client.Factory) *cobra.Command {
 	o := NewRejectOptions()
 
@@ -90,18 +89,21 @@ func (o *RejectOptions) Validate(c *cobra.Command, args []string, f client.Facto
 }
 
 func (o *RejectOptions) Run(c *cobra.Command, f client.Factory) error {
+	ctx, cancel := shared.RequestContext()
+	defer cancel()
+
 	// Get the admin namespace (from client config) where requests are stored
 	adminNS := f.Namespace()
 
 	// Find the request either by UUID or by looking up NABSL name
-	requestName, err := shared.FindNABSLRequestByNameOrUUID(context.Background(), o.client, o.RequestName, adminNS)
+	requestName, err := shared.FindNABSLRequestByNameOrUUID(ctx, o.client, o.RequestName, adminNS)
 	if err != nil {
 		return err
 	}
 
 	// Get the current request
 	var request nacv1alpha1.NonAdminBackupStorageLocationRequest
-	err = o.client.Get(context.Background(), kbclient.ObjectKey{
+	err = o.client.Get(ctx, kbclient.ObjectKey{
 		Name:      requestName,
 		Namespace: adminNS,
 	}, &request)
@@ -121,10 +123,10 @@ func (o *RejectOptions) Run(c *cobra.Command, f client.Factory) error {
 		if request.Annotations == nil {
 			request.Annotations = make(map[string]string)
 		}
-		request.Annotations["openshift.io/oadp-rejection-reason"] = o.Reason
+		request.Annotations[shared.NABSLRejectionReasonAnnotation] = o.Reason
 	}
 
-	err = o.client.Update(context.Background(), &request)
+	err = o.client.Update(ctx, &request)
 	if err != nil {
 		return fmt.Errorf("failed to deny request: %w", err)
 	}
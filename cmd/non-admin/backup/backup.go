@@ -19,15 +19,17 @@ limitations under the License.
 import (
 	"github.com/spf13/cobra"
 
+	"github.com/migtools/oadp-cli/cmd/shared"
 	"github.com/vmware-tanzu/velero/pkg/client"
 )
 
 // NewBackupCommand creates the "backup" subcommand under nonadmin
 func NewBackupCommand(f client.Factory) *cobra.Command {
 	c := &cobra.Command{
-		Use:   "backup",
-		Short: "Work with non-admin backups",
-		Long:  "Work with non-admin backups",
+		Use:              "backup",
+		Short:            "Work with non-admin backups",
+		Long:             "Work with non-admin backups",
+		PersistentPreRun: shared.NonAdminPreRun(f),
 	}
 
 	c.AddCommand(
@@ -36,6 +38,7 @@ func NewBackupCommand(f client.Factory) *cobra.Command {
 		NewLogsCommand(f, "logs"),
 		NewDescribeCommand(f, "describe"),
 		NewDeleteCommand(f, "delete"),
+		NewCollectCommand(f, "collect"),
 	)
 
 	return c
@@ -54,6 +54,7 @@ func TestNonAdminBackupCommands(t *testing.T) {
 				"--wait",
 				"--force",
 				"--assume-yes",
+				"--confirm",
 			},
 		},
 		{
@@ -69,6 +70,7 @@ func TestNonAdminBackupCommands(t *testing.T) {
 			expectContains: []string{
 				"Delete one or more non-admin backups",
 				"--confirm",
+				"--assume-yes",
 			},
 		},
 		{
@@ -85,6 +87,14 @@ func TestNonAdminBackupCommands(t *testing.T) {
 				"Show logs for a non-admin backup",
 			},
 		},
+		{
+			name: "nonadmin backup collect help",
+			args: []string{"nonadmin", "backup", "collect", "--help"},
+			expectContains: []string{
+				"Collect describe output, logs and the object of a non-admin backup",
+				"--output-dir",
+			},
+		},
 		{
 			name: "na backup shorthand help",
 			args: []string{"na", "backup", "--help"},
@@ -0,0 +1,246 @@
+package backup
+
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/migtools/oadp-cli/cmd/shared"
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/util/encode"
+)
+
+const (
+	collectDescribeFile = "describe.txt"
+	collectLogsFile     = "logs.txt"
+	collectRawLogsFile  = "logs.txt.gz"
+	collectObjectFile   = "object.yaml"
+)
+
+// NewCollectCommand creates a cobra command that bundles everything about a non-admin backup
+func NewCollectCommand(f client.Factory, use string) *cobra.Command {
+	o := NewCollectOptions()
+
+	c := &cobra.Command{
+		Use:   use + " NAME",
+		Short: "Collect describe output, logs and the object of a non-admin backup into a directory",
+		Long: `Collect describe output, logs and the object of a non-admin backup into a directory.
+
+The directory will contain describe.txt, logs.txt and object.yaml. If an artifact cannot
+be collected (e.g. logs are not yet available), a <file>.err note is written instead and
+collection continues.
+
+Logs are decompressed by default; use --raw to keep them gzip-compressed as logs.txt.gz.`,
+		Args: cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args, f))
+			cmd.CheckError(o.Validate())
+			cmd.CheckError(o.Run(c))
+		},
+		Example: `  # Collect a support bundle for a backup into ./my-backup-bundle
+  kubectl oadp nonadmin backup collect my-backup
+
+  # Collect a support bundle into a specific directory
+  kubectl oadp nonadmin backup collect my-backup --output-dir ./bundle
+
+  # Keep the logs gzip-compressed to save space
+  kubectl oadp nonadmin backup collect my-backup --raw`,
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+// CollectOptions holds the options for the collect command
+type CollectOptions struct {
+	Name      string
+	OutputDir string
+	Raw       bool
+	Download  shared.DownloadHTTPOptions
+	Namespace string // Internal field - automatically determined from kubectl context
+	client    kbclient.Client
+}
+
+// NewCollectOptions creates a new CollectOptions instance
+func NewCollectOptions() *CollectOptions {
+	return &CollectOptions{}
+}
+
+// BindFlags binds the command line flags to the options
+func (o *CollectOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&o.OutputDir, "output-dir", "", "Directory to write the bundle to (defaults to ./NAME-bundle)")
+	flags.BoolVar(&o.Raw, "raw", false, "Keep the logs gzip-compressed (written as logs.txt.gz)")
+	o.Download.BindFlags(flags)
+}
+
+// Complete completes the options by setting up the client and determining the namespace
+func (o *CollectOptions) Complete(args []string, f client.Factory) error {
+	o.Name = args[0]
+	if o.OutputDir == "" {
+		o.OutputDir = o.Name + "-bundle"
+	}
+
+	kbClient, err := shared.NewClientWithScheme(f, shared.ClientOptions{
+		IncludeNonAdminTypes: true,
+		IncludeVeleroTypes:   true,
+		IncludeCoreTypes:     true,
+	})
+	if err != nil {
+		return err
+	}
+	o.client = kbClient
+
+	currentNS, err := shared.GetCurrentNamespace()
+	if err != nil {
+		return fmt.Errorf("failed to determine current namespace: %w", err)
+	}
+	o.Namespace = currentNS
+
+	return nil
+}
+
+// Validate validates the options
+func (o *CollectOptions) Validate() error {
+	if o.Name == "" {
+		return fmt.Errorf("a backup name is required")
+	}
+	return nil
+}
+
+// Run collects the bundle
+func (o *CollectOptions) Run(c *cobra.Command) error {
+	httpClient, err := shared.NewDownloadHTTPClient(o.Download)
+	if err != nil {
+		return err
+	}
+
+	return collectBackupBundle(c.OutOrStdout(), o.client, httpClient, o.Namespace, o.Name, o.OutputDir, o.Raw)
+}
+
+// collectBackupBundle writes the object, describe output and logs of a NonAdminBackup
+// into outputDir. Failures collecting an individual artifact are recorded in a
+// <file>.err note and do not stop the remaining artifacts from being collected.
+// When raw is set the logs are stored gzip-compressed as downloaded.
+func collectBackupBundle(w io.Writer, kbClient kbclient.Client, httpClient *http.Client, namespace, name, outputDir string, raw bool) error {
+	ctx, cancel := shared.RequestContext()
+	defer cancel()
+
+	var nab nacv1alpha1.NonAdminBackup
+	if err := kbClient.Get(ctx, kbclient.ObjectKey{
+		Namespace: namespace,
+		Name:      name,
+	}, &nab); err != nil {
+		return fmt.Errorf("failed to get NonAdminBackup %q: %w", name, err)
+	}
+
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+
+	logsFile := collectLogsFile
+	if raw {
+		logsFile = collectRawLogsFile
+	}
+
+	artifacts := []struct {
+		file    string
+		collect func(io.Writer) error
+	}{
+		{
+			file: collectObjectFile,
+			collect: func(out io.Writer) error {
+				// Typed objects read from the API server have no TypeMeta, which the encoder needs
+				nab.SetGroupVersionKind(nacv1alpha1.GroupVersion.WithKind("NonAdminBackup"))
+				return encode.To(&nab, "yaml", out)
+			},
+		},
+		{
+			file: collectDescribeFile,
+			collect: func(out io.Writer) error {
+				ctx, cancel := context.WithTimeout(context.Background(), defaultDescribeTimeout)
+				defer cancel()
+
+				return NonAdminDescribeBackup(ctx, out, kbClient, httpClient, &nab, namespace)
+			},
+		},
+		{
+			file: logsFile,
+			collect: func(out io.Writer) error {
+				// Velero only uploads the logs once the backup finishes, so don't wait for them before then
+				if err := checkBackupLogsAvailable(&nab); err != nil {
+					return err
+				}
+
+				ctx, cancel := context.WithTimeout(context.Background(), 120*time.Second)
+				defer cancel()
+
+				url, err := shared.RequestDownloadURL(ctx, kbClient, namespace, velerov1.DownloadTargetKindBackupLog, name, shared.DownloadRequestOptions{})
+				if err != nil {
+					return err
+				}
+				if raw {
+					return shared.DownloadRaw(httpClient, url, out)
+				}
+				return shared.StreamGzippedLines(httpClient, url, out)
+			},
+		},
+	}
+
+	symbols := shared.Symbols(w)
+	failed := 0
+	for _, artifact := range artifacts {
+		path := filepath.Join(outputDir, artifact.file)
+
+		var buf bytes.Buffer
+		if err := artifact.collect(&buf); err != nil {
+			failed++
+			fmt.Fprintf(w, "%s Failed to collect %s: %v\n", symbols.Fail, artifact.file, err)
+			if writeErr := os.WriteFile(path+".err", []byte(err.Error()+"\n"), 0644); writeErr != nil {
+				return fmt.Errorf("failed to write %s.err: %w", artifact.file, writeErr)
+			}
+			continue
+		}
+
+		if err := os.WriteFile(path, buf.Bytes(), 0644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", artifact.file, err)
+		}
+		fmt.Fprintf(w, "%s Collected %s (%s)\n", symbols.OK, artifact.file, shared.FormatBytes(int64(buf.Len())))
+	}
+
+	fmt.Fprintf(w, "\nBundle for NonAdminBackup %q written to %s", name, outputDir)
+	if failed > 0 {
+		fmt.Fprintf(w, " (%d artifact(s) could not be collected, see the .err files)", failed)
+	}
+	fmt.Fprintln(w)
+
+	return nil
+}
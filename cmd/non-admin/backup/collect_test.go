@@ -0,0 +1,192 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/migtools/oadp-cli/cmd/shared"
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// TestCollectBackupBundle verifies that collect writes every artifact, and falls back to
+// an .err note when an artifact cannot be downloaded
+func TestCollectBackupBundle(t *testing.T) {
+	const logLine = `level=info msg="Backup completed"`
+
+	var gzipped bytes.Buffer
+	gzw := gzip.NewWriter(&gzipped)
+	_, _ = gzw.Write([]byte(logLine + "\n"))
+	if err := gzw.Close(); err != nil {
+		t.Fatalf("Failed to gzip logs: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/"+string(velerov1.DownloadTargetKindBackupLog) {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write(gzipped.Bytes())
+	}))
+	defer server.Close()
+
+	newNAB := func(phase velerov1.BackupPhase) *nacv1alpha1.NonAdminBackup {
+		return &nacv1alpha1.NonAdminBackup{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-backup", Namespace: "my-project"},
+			Status: nacv1alpha1.NonAdminBackupStatus{
+				Phase: nacv1alpha1.NonAdminPhaseCreated,
+				VeleroBackup: &nacv1alpha1.VeleroBackup{
+					Status: &velerov1.BackupStatus{Phase: phase},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		phase        velerov1.BackupPhase
+		processed    bool
+		raw          bool
+		logsFile     string
+		expectLogs   []byte
+		expectLogErr string
+	}{
+		{
+			name:       "all artifacts collected",
+			phase:      velerov1.BackupPhaseCompleted,
+			processed:  true,
+			logsFile:   collectLogsFile,
+			expectLogs: []byte(logLine + "\n"),
+		},
+		{
+			name:       "raw logs kept compressed",
+			phase:      velerov1.BackupPhaseCompleted,
+			processed:  true,
+			raw:        true,
+			logsFile:   collectRawLogsFile,
+			expectLogs: gzipped.Bytes(),
+		},
+		{
+			name:         "logs unavailable",
+			phase:        velerov1.BackupPhaseCompleted,
+			processed:    false,
+			logsFile:     collectLogsFile,
+			expectLogErr: "backup logs not found",
+		},
+		{
+			name:         "backup not finished",
+			phase:        velerov1.BackupPhaseInProgress,
+			logsFile:     collectLogsFile,
+			expectLogErr: "logs are not available until the backup completes (current phase: InProgress)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := interceptor.NewClient(newFakeClient(t, newNAB(tt.phase)), interceptor.Funcs{
+				Create: func(ctx context.Context, c kbclient.WithWatch, obj kbclient.Object, opts ...kbclient.CreateOption) error {
+					if req, ok := obj.(*nacv1alpha1.NonAdminDownloadRequest); ok {
+						if tt.phase != velerov1.BackupPhaseCompleted && req.Spec.Target.Kind == velerov1.DownloadTargetKindBackupLog {
+							t.Error("expected no logs download request for an unfinished backup")
+						}
+						if !tt.processed {
+							req.Status.Conditions = []metav1.Condition{{
+								Type:    string(nacv1alpha1.ConditionNonAdminProcessed),
+								Status:  metav1.ConditionTrue,
+								Reason:  "Error",
+								Message: "backup logs not found",
+							}}
+						} else {
+							req.Status.Conditions = []metav1.Condition{{
+								Type:   string(nacv1alpha1.ConditionNonAdminProcessed),
+								Status: metav1.ConditionTrue,
+							}}
+							req.Status.VeleroDownloadRequest.Status = &velerov1.DownloadRequestStatus{
+								DownloadURL: server.URL + "/" + string(req.Spec.Target.Kind),
+							}
+						}
+					}
+					return c.Create(ctx, obj, opts...)
+				},
+			})
+
+			outputDir := filepath.Join(t.TempDir(), "bundle")
+			var out bytes.Buffer
+			if err := collectBackupBundle(&out, client, server.Client(), "my-project", "my-backup", outputDir, tt.raw); err != nil {
+				t.Fatalf("collectBackupBundle() error = %v", err)
+			}
+
+			object, err := os.ReadFile(filepath.Join(outputDir, collectObjectFile))
+			if err != nil {
+				t.Fatalf("expected %s: %v", collectObjectFile, err)
+			}
+			if !strings.Contains(string(object), "kind: NonAdminBackup") || !strings.Contains(string(object), "name: my-backup") {
+				t.Errorf("unexpected %s content:\n%s", collectObjectFile, object)
+			}
+
+			describe, err := os.ReadFile(filepath.Join(outputDir, collectDescribeFile))
+			if err != nil {
+				t.Fatalf("expected %s: %v", collectDescribeFile, err)
+			}
+			if !strings.Contains(string(describe), "my-backup") {
+				t.Errorf("unexpected %s content:\n%s", collectDescribeFile, describe)
+			}
+
+			if tt.expectLogs != nil {
+				logs, err := os.ReadFile(filepath.Join(outputDir, tt.logsFile))
+				if err != nil {
+					t.Fatalf("expected %s: %v", tt.logsFile, err)
+				}
+				if !bytes.Equal(logs, tt.expectLogs) {
+					t.Errorf("unexpected %s content: got %q, want %q", tt.logsFile, logs, tt.expectLogs)
+				}
+				size := fmt.Sprintf("Collected %s (%s)", tt.logsFile, shared.FormatBytes(int64(len(tt.expectLogs))))
+				if !strings.Contains(out.String(), size) {
+					t.Errorf("expected output to report %q, got:\n%s", size, out.String())
+				}
+			}
+
+			note, err := os.ReadFile(filepath.Join(outputDir, tt.logsFile+".err"))
+			if tt.expectLogErr == "" {
+				if err == nil {
+					t.Errorf("expected no %s.err, got %q", tt.logsFile, note)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected %s.err: %v", tt.logsFile, err)
+			}
+			if !strings.Contains(string(note), tt.expectLogErr) {
+				t.Errorf("expected %s.err to contain %q, got %q", tt.logsFile, tt.expectLogErr, note)
+			}
+		})
+	}
+}
@@ -17,16 +17,25 @@ limitations under the License.
 */
 
 import (
-	"bufio"
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"maps"
 	"os"
+	"os/signal"
+	"slices"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
-	"k8s.io/client-go/tools/cache"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/migtools/oadp-cli/cmd/shared"
@@ -37,7 +46,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/cmd"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
-	"github.com/vmware-tanzu/velero/pkg/util/kube"
+	"github.com/vmware-tanzu/velero/pkg/util/encode"
 )
 
 func NewCreateCommand(f client.Factory, use string) *cobra.Command {
@@ -46,9 +55,16 @@ func NewCreateCommand(f client.Factory, use string) *cobra.Command {
 	c := &cobra.Command{
 		Use:   use + " NAME",
 		Short: "Create a non-admin backup",
-		Args:  cobra.MaximumNArgs(1),
+		Long: `Create a non-admin backup
+
+Flags not given on the command line are read from ~/.config/oadp/backup-defaults.yaml
+if it exists. Its keys are flag names, e.g. "storage-location: my-nabsl" or
+"snapshot-move-data: true", so a team can share the same defaults.`,
+		Args: cobra.MaximumNArgs(1),
+		// -o without --wait only prints the object, so the NAC check is skipped
+		Annotations: map[string]string{shared.PreviewAnnotation: ""},
 		Run: func(c *cobra.Command, args []string) {
-			cmd.CheckError(o.Complete(args, f))
+			cmd.CheckError(o.Complete(args, f, c.ErrOrStderr()))
 			cmd.CheckError(o.Validate(c, args, f))
 			cmd.CheckError(o.Run(c, f))
 		},
@@ -71,7 +87,22 @@ func NewCreateCommand(f client.Factory, use string) *cobra.Command {
   kubectl oadp nonadmin backup create backup6 --snapshot-volumes=false --storage-location my-nabsl -o yaml
 
   # Wait for a non-admin backup to complete before returning from the command.
-  kubectl oadp nonadmin backup create backup7 --wait --storage-location my-nabsl`,
+  kubectl oadp nonadmin backup create backup7 --wait --storage-location my-nabsl
+
+  # Create a non-admin backup unless one with the same name already exists, e.g. in scripts.
+  kubectl oadp nonadmin backup create backup8 --storage-location my-nabsl --if-not-exists
+
+  # Check a non-admin backup against server-side validation and admission without creating it.
+  kubectl oadp nonadmin backup create backup9 --storage-location my-nabsl --dry-run=server
+
+  # Print the backup as YAML for a specific NonAdminBackup API version.
+  kubectl oadp nonadmin backup create backup10 --storage-location my-nabsl -o yaml --output-version v1alpha1
+
+  # Wait for a non-admin backup, then print the finished backup as YAML; progress goes to stderr.
+  kubectl oadp nonadmin backup create backup11 --storage-location my-nabsl --wait -o yaml > backup11.yaml
+
+  # Check the resource filters against the resources the cluster serves before creating the backup.
+  kubectl oadp nonadmin backup create backup12 --include-resources deployments.apps,configmaps --storage-location my-nabsl --validate-resources`,
 	}
 
 	o.BindFlags(c.Flags())
@@ -80,15 +111,69 @@ func NewCreateCommand(f client.Factory, use string) *cobra.Command {
 	output.BindFlags(c.Flags())
 	output.ClearOutputFlagDefault(c)
 
+	_ = c.RegisterFlagCompletionFunc("storage-location", func(c *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return completeStorageLocations(f, toComplete)
+	})
+
 	return c
 }
 
+// completeStorageLocations returns the approved NABSLs in the current namespace as
+// completion candidates for --storage-location
+func completeStorageLocations(f client.Factory, toComplete string) ([]string, cobra.ShellCompDirective) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	kbClient, err := shared.NewClientWithScheme(f, shared.ClientOptions{
+		IncludeNonAdminTypes: true,
+	})
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	currentNS, err := shared.GetCurrentNamespace()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	names, err := approvedStorageLocationNames(ctx, kbClient, currentNS, toComplete)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
+
+// approvedStorageLocationNames lists NABSLs in the namespace that have been approved
+// by the cluster admin (or already have a Velero BSL created) and match the prefix
+func approvedStorageLocationNames(ctx context.Context, kbClient kbclient.Client, namespace, prefix string) ([]string, error) {
+	var nabslList nacv1alpha1.NonAdminBackupStorageLocationList
+	if err := kbClient.List(ctx, &nabslList, kbclient.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, nabsl := range nabslList.Items {
+		if !strings.HasPrefix(nabsl.Name, prefix) {
+			continue
+		}
+		approved := meta.IsStatusConditionTrue(nabsl.Status.Conditions, string(nacv1alpha1.NonAdminBSLConditionApproved))
+		if approved || nabsl.Status.Phase == nacv1alpha1.NonAdminPhaseCreated {
+			names = append(names, nabsl.Name)
+		}
+	}
+	sort.Strings(names)
+
+	return names, nil
+}
+
 type CreateOptions struct {
 	Name                            string
 	TTL                             time.Duration
 	SnapshotVolumes                 flag.OptionalBool
 	SnapshotMoveData                flag.OptionalBool
 	DataMover                       string
+	ForceDataMover                  bool
 	DefaultVolumesToFsBackup        flag.OptionalBool
 	IncludeResources                flag.StringArray
 	ExcludeResources                flag.StringArray
@@ -98,6 +183,7 @@ type CreateOptions struct {
 	ExcludeNamespaceScopedResources flag.StringArray
 	Labels                          flag.Map
 	Annotations                     flag.Map
+	ReservedKeyPrefixes             []string
 	Selector                        flag.LabelSelector
 	OrSelector                      flag.OrLabelSelector
 	IncludeClusterResources         flag.OptionalBool
@@ -111,9 +197,20 @@ type CreateOptions struct {
 	ResPoliciesConfigmap            string
 	Force                           bool
 	AssumeYes                       bool
+	IfNotExists                     bool
+	DryRun                          string
+	OutputVersion                   string
+	InheritMetadata                 bool
+	outputAPIVersion                string
+	schedule                        *velerov1api.Schedule
 	client                          kbclient.WithWatch
 	ParallelFilesUpload             int
+	Strict                          bool
+	ValidateResources               bool
+	discoveryClient                 discovery.ServerResourcesInterface
 	currentNamespace                string
+	// flags is the flag set the options are bound to, for applying the backup defaults file
+	flags *pflag.FlagSet
 }
 
 func NewCreateOptions() *CreateOptions {
@@ -121,12 +218,14 @@ func NewCreateOptions() *CreateOptions {
 		IncludeResources:        flag.NewStringArray("*"),
 		Labels:                  flag.NewMap(),
 		Annotations:             flag.NewMap(),
+		ReservedKeyPrefixes:     defaultReservedKeyPrefixes,
 		SnapshotVolumes:         flag.NewOptionalBool(nil),
 		IncludeClusterResources: flag.NewOptionalBool(nil),
 	}
 }
 
 func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
+	o.flags = flags
 	flags.DurationVar(&o.TTL, "ttl", o.TTL, "How long before the backup can be garbage collected.")
 	flags.Var(&o.IncludeResources, "include-resources", "Resources to include in the backup, formatted as resource.group, such as storageclasses.storage.k8s.io (use '*' for all resources). Cannot work with include-cluster-scoped-resources, exclude-cluster-scoped-resources, include-namespace-scoped-resources and exclude-namespace-scoped-resources.")
 	flags.Var(&o.ExcludeResources, "exclude-resources", "Resources to exclude from the backup, formatted as resource.group, such as storageclasses.storage.k8s.io. Cannot work with include-cluster-scoped-resources, exclude-cluster-scoped-resources, include-namespace-scoped-resources and exclude-namespace-scoped-resources.")
@@ -136,6 +235,7 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.Var(&o.ExcludeNamespaceScopedResources, "exclude-namespace-scoped-resources", "Namespaced resources to exclude from the backup, formatted as resource.group, such as deployments.apps(use '*' for all resources). Cannot work with include-resources, exclude-resources and include-cluster-resources.")
 	flags.Var(&o.Labels, "labels", "Labels to apply to the backup.")
 	flags.Var(&o.Annotations, "annotations", "Annotations to apply to the backup.")
+	flags.StringSliceVar(&o.ReservedKeyPrefixes, "reserved-key-prefixes", o.ReservedKeyPrefixes, "Label and annotation key prefixes that --labels and --annotations may not use, since the non-admin controller and Velero manage them.")
 	flags.StringVar(&o.StorageLocation, "storage-location", "", "Location in which to store the backup.")
 	flags.StringSliceVar(&o.SnapshotLocations, "volume-snapshot-locations", o.SnapshotLocations, "List of locations (at most one per provider) where volume snapshots should be stored.")
 	flags.VarP(&o.Selector, "selector", "l", "Only back up resources matching this label selector.")
@@ -148,7 +248,7 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	// like a normal bool flag
 	f.NoOptDefVal = cmd.TRUE
 
-	f = flags.VarPF(&o.SnapshotMoveData, "snapshot-move-data", "", "Specify whether snapshot data should be moved")
+	f = flags.VarPF(&o.SnapshotMoveData, "snapshot-move-data", "", "Specify whether snapshot data should be moved. Defaults to true when the OADP admin enforces data mover for non-admin backups")
 	f.NoOptDefVal = cmd.TRUE
 
 	f = flags.VarPF(&o.IncludeClusterResources, "include-cluster-resources", "", "Include cluster-scoped resources in the backup. Cannot work with include-cluster-scoped-resources, exclude-cluster-scoped-resources, include-namespace-scoped-resources and exclude-namespace-scoped-resources.")
@@ -159,28 +259,49 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 
 	flags.StringVar(&o.ResPoliciesConfigmap, "resource-policies-configmap", "", "Reference to the resource policies configmap that backup should use")
 	flags.StringVar(&o.DataMover, "data-mover", "", "Specify the data mover to be used by the backup. If the parameter is not set or set as 'velero', the built-in data mover will be used")
+	flags.BoolVar(&o.ForceDataMover, "force-data-mover", o.ForceDataMover, "Allow a --data-mover that is not known to the CLI or the OADP configuration.")
 	flags.IntVar(&o.ParallelFilesUpload, "parallel-files-upload", 0, "Number of files uploads simultaneously when running a backup. This is only applicable for the kopia uploader")
+	flags.BoolVar(&o.Strict, "strict", false, "Fail instead of warning when a flag will be ignored or conflicts with another, such as --parallel-files-upload for a backup that doesn't use the kopia uploader")
+	flags.BoolVar(&o.ValidateResources, "validate-resources", false, "Check that each --include-resources and --exclude-resources entry, and their cluster- and namespace-scoped variants, is served by the cluster, suggesting close matches for typos. Makes extra discovery requests")
 	flags.BoolVarP(&o.Force, "force", "f", o.Force, "Force creation without specifying a storage location (uses admin defaults).")
-	flags.BoolVarP(&o.AssumeYes, "assume-yes", "y", o.AssumeYes, "Assume yes to all prompts and run non-interactively.")
+	shared.BindConfirmFlags(flags, &o.AssumeYes)
+	flags.BoolVar(&o.IfNotExists, "if-not-exists", o.IfNotExists, "Succeed without changes if a non-admin backup with the same name already exists.")
+	shared.BindDryRunFlag(flags, &o.DryRun)
+	flags.StringVar(&o.OutputVersion, "output-version", "", "The API version, such as v1alpha1, of the object printed by -o json or -o yaml. Defaults to the version the CLI creates")
 }
 
 // BindWait binds the wait flag separately so it is not called by other create
 // commands that reuse CreateOptions's BindFlags method.
 func (o *CreateOptions) BindWait(flags *pflag.FlagSet) {
-	flags.BoolVarP(&o.Wait, "wait", "w", o.Wait, "Wait for the operation to complete.")
+	flags.BoolVarP(&o.Wait, "wait", "w", o.Wait, "Wait for the operation to complete. Exits non-zero if the backup fails or partially fails.")
 }
 
 // BindFromSchedule binds the from-schedule flag separately so it is not called
 // by other create commands that reuse CreateOptions's BindFlags method.
 func (o *CreateOptions) BindFromSchedule(flags *pflag.FlagSet) {
 	flags.StringVar(&o.FromSchedule, "from-schedule", "", "Create a backup from the template of an existing schedule. Cannot be used with any other filters. Backup name is optional if used.")
+	flags.BoolVar(&o.InheritMetadata, "inherit-metadata", false, "When using --from-schedule, copy the schedule's labels and annotations onto the backup. Values set with --labels and --annotations take precedence.")
 }
 
 func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
+	ctx, cancel := shared.RequestContext()
+	defer cancel()
+
 	if err := output.ValidateFlags(c); err != nil {
 		return err
 	}
 
+	if o.OutputVersion != "" {
+		if format := output.GetOutputFlagValue(c); format != "json" && format != "yaml" {
+			return fmt.Errorf("--output-version can only be used with -o json or -o yaml")
+		}
+		apiVersion, err := resolveOutputVersion(o.OutputVersion)
+		if err != nil {
+			return err
+		}
+		o.outputAPIVersion = apiVersion
+	}
+
 	if o.Selector.LabelSelector != nil && o.OrSelector.OrLabelSelectors != nil {
 		return fmt.Errorf("either a 'selector' or an 'or-selector' can be specified, but not both")
 	}
@@ -195,12 +316,37 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return fmt.Errorf("a backup name is required, unless you are creating based on a schedule")
 	}
 
+	if o.InheritMetadata && o.FromSchedule == "" {
+		return fmt.Errorf("--inherit-metadata can only be used with --from-schedule")
+	}
+
+	if err := o.validateReservedKeys(); err != nil {
+		return err
+	}
+
+	if o.FromSchedule != "" {
+		schedule := new(velerov1api.Schedule)
+		if err := o.client.Get(ctx, kbclient.ObjectKey{Namespace: o.currentNamespace, Name: o.FromSchedule}, schedule); err != nil {
+			if apierrors.IsNotFound(err) {
+				return fmt.Errorf("schedule %q not found in namespace %q", o.FromSchedule, o.currentNamespace)
+			}
+			return fmt.Errorf("failed to get schedule %q: %w", o.FromSchedule, err)
+		}
+		o.schedule = schedule
+	}
+
 	if o.oldAndNewFilterParametersUsedTogether() {
 		return fmt.Errorf("include-resources, exclude-resources and include-cluster-resources are old filter parameters.\n" +
 			"include-cluster-scoped-resources, exclude-cluster-scoped-resources, include-namespace-scoped-resources and exclude-namespace-scoped-resources are new filter parameters.\n" +
 			"They cannot be used together")
 	}
 
+	if o.ValidateResources {
+		if err := o.validateResources(); err != nil {
+			return err
+		}
+	}
+
 	// Note: Storage location and snapshot location validation removed for NonAdminBackup
 	// as these are typically managed by the underlying Velero backup resource
 
@@ -208,9 +354,135 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return fmt.Errorf("a valid NonAdminBackupStorageLocation must be provided via --storage-location, or use --force to create with admin defaults")
 	}
 
+	if err := shared.ValidateDryRun(o.DryRun); err != nil {
+		return err
+	}
+	if o.DryRun == shared.DryRunServer {
+		if _, err := shared.DryRunOutputFormat(c); err != nil {
+			return err
+		}
+	}
+	if o.Wait && o.DryRun == shared.DryRunServer {
+		return fmt.Errorf("--wait cannot be used with --dry-run=server")
+	}
+
+	if err := o.validateParallelFilesUpload(c.ErrOrStderr()); err != nil {
+		return err
+	}
+	if err := o.validateSnapshotVolumes(c.ErrOrStderr()); err != nil {
+		return err
+	}
+
+	return o.validateDataMover(ctx)
+}
+
+// defaultReservedKeyPrefixes are the label and annotation key prefixes the non-admin
+// controller and Velero set on backups, e.g. openshift.io/oadp-nab-origin-nacuuid and
+// velero.io/backup-name
+var defaultReservedKeyPrefixes = []string{"openshift.io/oadp", "velero.io/"}
+
+// validateReservedKeys rejects --labels and --annotations keys under a reserved prefix,
+// since overriding them can break the controller's reconciliation of the backup
+func (o *CreateOptions) validateReservedKeys() error {
+	for _, metadata := range []struct {
+		flag string
+		data map[string]string
+	}{
+		{"--labels", o.Labels.Data()},
+		{"--annotations", o.Annotations.Data()},
+	} {
+		for _, key := range slices.Sorted(maps.Keys(metadata.data)) {
+			for _, prefix := range o.ReservedKeyPrefixes {
+				if prefix != "" && strings.HasPrefix(key, prefix) {
+					return fmt.Errorf("%s key %q uses the reserved prefix %q, which the non-admin controller and Velero manage", metadata.flag, key, prefix)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// validateParallelFilesUpload warns (or errors with --strict) when --parallel-files-upload is
+// set for a backup that won't use the kopia uploader, which is only used for fs-backup and
+// data mover backups
+func (o *CreateOptions) validateParallelFilesUpload(w io.Writer) error {
+	if o.ParallelFilesUpload <= 0 || o.FromSchedule != "" {
+		return nil
+	}
+	usesKopia := func(b flag.OptionalBool) bool { return b.Value != nil && *b.Value }
+	if usesKopia(o.DefaultVolumesToFsBackup) || usesKopia(o.SnapshotMoveData) {
+		return nil
+	}
+
+	if o.Strict {
+		return fmt.Errorf("--parallel-files-upload only applies to the kopia uploader; use it with --default-volumes-to-fs-backup or --snapshot-move-data")
+	}
+	fmt.Fprintln(w, "Warning: --parallel-files-upload only applies to the kopia uploader, used with --default-volumes-to-fs-backup or --snapshot-move-data; it will be ignored.")
+	return nil
+}
+
+// validateSnapshotVolumes warns (or errors with --strict) when both --snapshot-volumes and
+// --default-volumes-to-fs-backup are explicitly true. Velero backs a volume up only once and
+// fs-backup takes precedence, so only volumes opted out of fs-backup are snapshotted.
+func (o *CreateOptions) validateSnapshotVolumes(w io.Writer) error {
+	isTrue := func(b flag.OptionalBool) bool { return b.Value != nil && *b.Value }
+	if !isTrue(o.SnapshotVolumes) || !isTrue(o.DefaultVolumesToFsBackup) {
+		return nil
+	}
+
+	const explanation = "Velero backs up volumes with fs-backup when --default-volumes-to-fs-backup is set, " +
+		"so only volumes excluded with the backup.velero.io/backup-volumes-excludes annotation are snapshotted"
+	if o.Strict {
+		return fmt.Errorf("--snapshot-volumes and --default-volumes-to-fs-backup conflict: %s", explanation)
+	}
+	fmt.Fprintf(w, "Warning: --snapshot-volumes and --default-volumes-to-fs-backup are both set. %s.\n", explanation)
 	return nil
 }
 
+// builtinDataMover is the data mover Velero uses when none is specified
+const builtinDataMover = "velero"
+
+// validateResources checks the resource filter flags against the resources the cluster
+// serves, since a typo such as deployment.apps silently matches nothing
+func (o *CreateOptions) validateResources() error {
+	index, err := newResourceIndex(o.discoveryClient)
+	if err != nil {
+		return err
+	}
+
+	for _, filter := range []struct {
+		flag      string
+		resources flag.StringArray
+	}{
+		{"include-resources", o.IncludeResources},
+		{"exclude-resources", o.ExcludeResources},
+		{"include-cluster-scoped-resources", o.IncludeClusterScopedResources},
+		{"exclude-cluster-scoped-resources", o.ExcludeClusterScopedResources},
+		{"include-namespace-scoped-resources", o.IncludeNamespaceScopedResources},
+		{"exclude-namespace-scoped-resources", o.ExcludeNamespaceScopedResources},
+	} {
+		if err := index.check(filter.flag, filter.resources); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateDataMover rejects a --data-mover that isn't a known mover, since Velero
+// never picks up a backup whose data mover has no controller. --force-data-mover
+// allows movers the CLI doesn't know about yet.
+func (o *CreateOptions) validateDataMover(ctx context.Context) error {
+	if o.DataMover == "" || o.ForceDataMover {
+		return nil
+	}
+
+	movers := knownDataMovers(ctx, o.client, o.currentNamespace, o.StorageLocation)
+	if slices.Contains(movers, o.DataMover) {
+		return nil
+	}
+	return fmt.Errorf("unknown data mover %q, valid options are: %s (use --force-data-mover to use it anyway)", o.DataMover, strings.Join(movers, ", "))
+}
+
 func (o *CreateOptions) validateFromScheduleFlag(c *cobra.Command) error {
 	trimmed := strings.TrimSpace(o.FromSchedule)
 	if c.Flags().Changed("from-schedule") && trimmed == "" {
@@ -222,12 +494,25 @@ func (o *CreateOptions) validateFromScheduleFlag(c *cobra.Command) error {
 	return nil
 }
 
-func (o *CreateOptions) Complete(args []string, f client.Factory) error {
+// Complete fills in the options from the arguments and the cluster. Notes about defaults
+// read from the admin's enforced backup spec are written to errOut.
+func (o *CreateOptions) Complete(args []string, f client.Factory, errOut io.Writer) error {
 	// If an explicit name is specified, use that name
 	if len(args) > 0 {
 		o.Name = args[0]
 	}
 
+	// Flags on the command line win over the team's defaults file
+	if o.flags != nil {
+		path, err := backupDefaultsPath()
+		if err != nil {
+			return err
+		}
+		if err := applyBackupDefaults(o.flags, path); err != nil {
+			return err
+		}
+	}
+
 	// Create client with NonAdmin scheme
 	client, err := shared.NewClientWithScheme(f, shared.ClientOptions{
 		IncludeNonAdminTypes: true,
@@ -244,141 +529,369 @@ func (o *CreateOptions) Complete(args []string, f client.Factory) error {
 
 	o.client = client
 	o.currentNamespace = currentNS
+
+	if o.ValidateResources {
+		config, err := shared.RestConfig(f)
+		if err != nil {
+			return err
+		}
+		discoveryClient, err := discovery.NewDiscoveryClientForConfig(config)
+		if err != nil {
+			return fmt.Errorf("failed to create discovery client: %w", err)
+		}
+		o.discoveryClient = discoveryClient
+	}
+
+	ctx, cancel := shared.RequestContext()
+	defer cancel()
+
+	o.applySnapshotMoveDataDefault(ctx, errOut)
+	o.warnTTLNotEnforced(ctx, errOut)
 	return nil
 }
 
+// dpaListGVK identifies OADP DataProtectionApplications, which are read unstructured
+// since the CLI doesn't depend on the operator API
+var dpaListGVK = schema.GroupVersionKind{Group: "oadp.openshift.io", Version: "v1alpha1", Kind: "DataProtectionApplicationList"}
+
+// applySnapshotMoveDataDefault defaults --snapshot-move-data to true when it wasn't set
+// explicitly and the admin enforces data mover for the chosen storage location
+func (o *CreateOptions) applySnapshotMoveDataDefault(ctx context.Context, w io.Writer) {
+	if o.SnapshotMoveData.Value != nil || o.StorageLocation == "" || o.FromSchedule != "" {
+		return
+	}
+
+	if moveData, found := enforcedSnapshotMoveData(ctx, o.client, o.currentNamespace, o.StorageLocation); found && moveData {
+		o.SnapshotMoveData.Value = &moveData
+		fmt.Fprintf(w, "Note: defaulting --snapshot-move-data=true because the OADP admin enforces data mover for backups to %q.\n", o.StorageLocation)
+	}
+}
+
+// enforcedSnapshotMoveData returns the snapshotMoveData value the admin enforces for
+// non-admin backups (spec.nonAdmin.enforceBackupSpec in the DataProtectionApplication)
+// in the OADP namespace backing the NABSL. found is false if there is none, or if the
+// NABSL or DPA can't be read, which is common for non-admin users.
+func enforcedSnapshotMoveData(ctx context.Context, kbClient kbclient.Client, namespace, storageLocation string) (moveData bool, found bool) {
+	for _, dpa := range readableDPAs(ctx, kbClient, namespace, storageLocation) {
+		moveData, found, err := unstructured.NestedBool(dpa.Object, "spec", "nonAdmin", "enforceBackupSpec", "snapshotMoveData")
+		if err == nil && found {
+			return moveData, true
+		}
+	}
+	return false, false
+}
+
+// warnTTLNotEnforced warns when --ttl differs from the TTL the admin enforces for the
+// chosen storage location, since NAC rejects backups whose TTL doesn't match it
+func (o *CreateOptions) warnTTLNotEnforced(ctx context.Context, w io.Writer) {
+	if o.TTL == 0 {
+		return
+	}
+
+	if ttl, found := enforcedTTL(ctx, o.client, o.currentNamespace, o.StorageLocation); found && o.TTL != ttl {
+		fmt.Fprintf(w, "Warning: --ttl %s differs from the TTL of %s the OADP admin enforces for backups to %q; the backup will be rejected. Omit --ttl to use the enforced value.\n", o.TTL, ttl, o.StorageLocation)
+	}
+}
+
+// enforcedTTL returns the TTL the admin enforces for non-admin backups, read like
+// enforcedSnapshotMoveData. found is false if there is none or it can't be read.
+func enforcedTTL(ctx context.Context, kbClient kbclient.Client, namespace, storageLocation string) (ttl time.Duration, found bool) {
+	for _, dpa := range readableDPAs(ctx, kbClient, namespace, storageLocation) {
+		value, found, err := unstructured.NestedString(dpa.Object, "spec", "nonAdmin", "enforceBackupSpec", "ttl")
+		if err != nil || !found {
+			continue
+		}
+		if ttl, err := time.ParseDuration(value); err == nil && ttl > 0 {
+			return ttl, true
+		}
+	}
+	return 0, false
+}
+
+// knownDataMovers returns the built-in data mover plus any data mover the admin enforces
+// for non-admin backups in the OADP namespace backing the NABSL, sorted
+func knownDataMovers(ctx context.Context, kbClient kbclient.Client, namespace, storageLocation string) []string {
+	movers := []string{builtinDataMover}
+	for _, dpa := range readableDPAs(ctx, kbClient, namespace, storageLocation) {
+		mover, found, err := unstructured.NestedString(dpa.Object, "spec", "nonAdmin", "enforceBackupSpec", "dataMover")
+		if err == nil && found && mover != "" && !slices.Contains(movers, mover) {
+			movers = append(movers, mover)
+		}
+	}
+	sort.Strings(movers)
+	return movers
+}
+
+// readableDPAs returns the DataProtectionApplications in the OADP namespace backing the
+// NABSL, or nil if there is no storage location or the NABSL or DPAs can't be read
+func readableDPAs(ctx context.Context, kbClient kbclient.Client, namespace, storageLocation string) []unstructured.Unstructured {
+	if storageLocation == "" {
+		return nil
+	}
+
+	var nabsl nacv1alpha1.NonAdminBackupStorageLocation
+	if err := kbClient.Get(ctx, kbclient.ObjectKey{Namespace: namespace, Name: storageLocation}, &nabsl); err != nil {
+		return nil
+	}
+	bsl := nabsl.Status.VeleroBackupStorageLocation
+	if bsl == nil || bsl.Namespace == "" {
+		return nil
+	}
+
+	dpaList := &unstructured.UnstructuredList{}
+	dpaList.SetGroupVersionKind(dpaListGVK)
+	if err := kbClient.List(ctx, dpaList, kbclient.InNamespace(bsl.Namespace)); err != nil {
+		return nil
+	}
+	return dpaList.Items
+}
+
 func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
+	nameDerived := o.FromSchedule != "" && o.Name == ""
+
 	nonAdminBackup, err := o.BuildNonAdminBackup(o.currentNamespace)
 	if err != nil {
 		return err
 	}
 
-	if printed, err := output.PrintWithFormat(c, nonAdminBackup); printed || err != nil {
-		return err
+	// --output-version only changes what is printed; Validate ensures it comes with -o json|yaml
+	if o.outputAPIVersion != "" {
+		nonAdminBackup.APIVersion = o.outputAPIVersion
+	}
+
+	// With --wait, -o json|yaml prints the finished backup instead of replacing the create,
+	// and the notes move to stderr so stdout can be piped. With --dry-run=server, -o is
+	// the format of the object the server returns.
+	format := output.GetOutputFlagValue(c)
+	printAfterWait := o.Wait && (format == "json" || format == "yaml")
+	if !printAfterWait && o.DryRun != shared.DryRunServer {
+		if printed, err := printNonAdminBackupObject(c, nonAdminBackup); printed || err != nil {
+			return err
+		}
+	}
+	notes := c.OutOrStdout()
+	if printAfterWait {
+		notes = c.ErrOrStderr()
 	}
 
 	if o.FromSchedule != "" {
-		fmt.Println("Creating non-admin backup from schedule, all other filters are ignored.")
+		fmt.Fprintln(notes, "Creating non-admin backup from schedule, all other filters are ignored.")
+		if nameDerived {
+			fmt.Fprintf(notes, "Using backup name %q derived from schedule %q.\n", nonAdminBackup.Name, o.FromSchedule)
+		}
 	}
 
-	// Warning prompt when using force flag without storage location
-	if o.Force && o.StorageLocation == "" {
-		fmt.Println("\nWARNING: Using --force without specifying a storage location is not ideal.")
-		fmt.Println("This will use admin defaults and certain features like logs may not work as expected.")
+	// Warning prompt when using force flag without storage location; a dry run changes nothing
+	if o.Force && o.StorageLocation == "" && o.DryRun != shared.DryRunServer {
+		fmt.Fprintln(notes, "\nWARNING: Using --force without specifying a storage location is not ideal.")
+		fmt.Fprintln(notes, "This will use admin defaults and certain features like logs may not work as expected.")
 
 		if !o.AssumeYes {
-			fmt.Print("Do you want to continue? (y/N): ")
-
-			reader := bufio.NewReader(os.Stdin)
-			response, err := reader.ReadString('\n')
+			confirmed, err := shared.Confirm(c.InOrStdin(), notes, "Do you want to continue?")
 			if err != nil {
-				return fmt.Errorf("failed to read user input: %w", err)
+				return err
 			}
-
-			response = strings.TrimSpace(strings.ToLower(response))
-			if response != "y" && response != "yes" {
-				fmt.Println("Operation cancelled.")
+			if !confirmed {
+				fmt.Fprintln(notes, "Operation cancelled.")
 				return nil
 			}
 		} else {
-			fmt.Println("Proceeding with --assume-yes flag.")
+			fmt.Fprintln(notes, "Proceeding without prompting.")
 		}
-		fmt.Println() // Add blank line for better formatting
+		fmt.Fprintln(notes) // Add blank line for better formatting
 	}
 
-	var updates chan *nacv1alpha1.NonAdminBackup
-	if o.Wait {
-		stop := make(chan struct{})
-		defer close(stop)
-
-		updates = make(chan *nacv1alpha1.NonAdminBackup)
-
-		lw := kube.InternalLW{
-			Client:     o.client,
-			Namespace:  o.currentNamespace,
-			ObjectList: new(nacv1alpha1.NonAdminBackupList),
-		}
-		backupInformer := cache.NewSharedInformer(&lw, &nacv1alpha1.NonAdminBackup{}, time.Second)
-		_, _ = backupInformer.AddEventHandler(
-			cache.FilteringResourceEventHandler{
-				FilterFunc: func(obj any) bool {
-					backup, ok := obj.(*nacv1alpha1.NonAdminBackup)
-
-					if !ok {
-						return false
-					}
-					return backup.Name == o.Name
-				},
-				Handler: cache.ResourceEventHandlerFuncs{
-					UpdateFunc: func(_, obj any) {
-						backup, ok := obj.(*nacv1alpha1.NonAdminBackup)
-						if !ok {
-							return
-						}
-						updates <- backup
-					},
-					DeleteFunc: func(obj any) {
-						backup, ok := obj.(*nacv1alpha1.NonAdminBackup)
-						if !ok {
-							return
-						}
-						updates <- backup
-					},
-				},
-			},
-		)
-
-		go backupInformer.Run(stop)
-	}
-
-	err = o.client.Create(context.TODO(), nonAdminBackup, &kbclient.CreateOptions{})
+	// Start the request timeout only after any confirmation prompt has been answered
+	ctx, cancel := shared.RequestContext()
+	defer cancel()
+	err = o.client.Create(ctx, nonAdminBackup, shared.DryRunCreateOptions(o.DryRun)...)
 	if err != nil {
+		if o.IfNotExists && apierrors.IsAlreadyExists(err) {
+			fmt.Fprintf(c.OutOrStdout(), "NonAdminBackup %q already exists, skipping creation.\n", nonAdminBackup.Name)
+			return nil
+		}
 		return err
 	}
 
+	if o.DryRun == shared.DryRunServer {
+		dryRunFormat, err := shared.DryRunOutputFormat(c)
+		if err != nil {
+			return err
+		}
+		// Typed objects returned by the API server have no TypeMeta, which the encoder needs
+		nonAdminBackup.SetGroupVersionKind(nacv1alpha1.GroupVersion.WithKind("NonAdminBackup"))
+		if o.outputAPIVersion != "" {
+			nonAdminBackup.APIVersion = o.outputAPIVersion
+		}
+		return encode.To(nonAdminBackup, dryRunFormat, c.OutOrStdout())
+	}
+
 	if o.Force && o.StorageLocation == "" {
-		fmt.Printf("NonAdminBackup request %q submitted successfully (using admin defaults).\n", nonAdminBackup.Name)
+		fmt.Fprintf(notes, "NonAdminBackup request %q submitted successfully (using admin defaults).\n", nonAdminBackup.Name)
 	} else {
-		fmt.Printf("NonAdminBackup request %q submitted successfully.\n", nonAdminBackup.Name)
+		fmt.Fprintf(notes, "NonAdminBackup request %q submitted successfully.\n", nonAdminBackup.Name)
 	}
 	if o.Wait {
-		fmt.Println("Waiting for non-admin backup to complete. You may safely press ctrl-c to stop waiting - your backup will continue in the background.")
-		ticker := time.NewTicker(time.Second)
-		defer ticker.Stop()
-
-		for {
-			select {
-			case <-ticker.C:
-				fmt.Print(".")
-			case backup, ok := <-updates:
-				if !ok {
-					fmt.Println("\nError waiting: unable to watch non-admin backups.")
-					return nil
-				}
+		// Progress goes to stderr, keeping stdout for the backup printed with -o
+		progress := c.ErrOrStderr()
+		fmt.Fprintln(progress, "Waiting for non-admin backup to complete. You may safely press ctrl-c to stop waiting - your backup will continue in the background.")
+
+		// Stop waiting on the first ctrl-c; the backup itself keeps running
+		interrupt := make(chan os.Signal, 1)
+		signal.Notify(interrupt, os.Interrupt)
+		defer signal.Stop(interrupt)
+
+		spinner := shared.NewSpinner(progress)
+		status, errorCount, err := waitForNonAdminBackup(context.Background(), o.client, o.currentNamespace, nonAdminBackup.Name, shared.WaitOptions{
+			Interrupt:        interrupt,
+			ProgressInterval: time.Second,
+			OnProgress:       spinner.Tick,
+			OnStatus:         func(status string) { spinner.SetStatus("Status: " + status) },
+		})
+		if errors.Is(err, shared.ErrWaitInterrupted) {
+			fmt.Fprintf(progress, "\nStopping wait; backup continues in the background. Check with `oadp nonadmin backup describe %s`.\n", nonAdminBackup.Name)
+			return nil
+		}
+		if err != nil {
+			fmt.Fprintln(progress)
+			return err
+		}
 
-				// Check NonAdminBackup status phase for completion states
-				if backup.Status.Phase == "BackupDone" || backup.Status.Phase == "BackupFailed" {
-					if o.Force && o.StorageLocation == "" {
-						fmt.Printf("\nNonAdminBackup completed with status: %s (using admin defaults). You may check for more information using the commands `oadp nonadmin backup describe %s` and `oadp nonadmin backup logs %s`.\n", backup.Status.Phase, backup.Name, backup.Name)
-					} else {
-						fmt.Printf("\nNonAdminBackup completed with status: %s. You may check for more information using the commands `oadp nonadmin backup describe %s` and `oadp nonadmin backup logs %s`.\n", backup.Status.Phase, backup.Name, backup.Name)
-					}
-					return nil
-				}
+		fmt.Fprintf(progress, "\n%s\n", o.waitCompletionMessage(nonAdminBackup.Name, status, errorCount))
+
+		if printAfterWait {
+			if err := o.printFinishedBackup(c, nonAdminBackup.Name); err != nil {
+				return err
 			}
 		}
+		return checkWaitStatus(nonAdminBackup.Name, status)
 	}
 
 	// Not waiting
 	if o.Force && o.StorageLocation == "" {
-		fmt.Printf("Run `oc oadp nonadmin backup describe %s` or `oc oadp nonadmin backup logs %s` for more details. (Created using admin defaults)\n", nonAdminBackup.Name, nonAdminBackup.Name)
+		fmt.Fprintf(notes, "Run `oc oadp nonadmin backup describe %s` or `oc oadp nonadmin backup logs %s` for more details. (Created using admin defaults)\n", nonAdminBackup.Name, nonAdminBackup.Name)
 	} else {
-		fmt.Printf("Run `oc oadp nonadmin backup describe %s` or `oc oadp nonadmin backup logs %s` for more details.\n", nonAdminBackup.Name, nonAdminBackup.Name)
+		fmt.Fprintf(notes, "Run `oc oadp nonadmin backup describe %s` or `oc oadp nonadmin backup logs %s` for more details.\n", nonAdminBackup.Name, nonAdminBackup.Name)
+	}
+
+	return nil
+}
+
+// waitCompletionMessage returns the line printed when --wait ends. Backups that finished
+// with errors are called out as partial, since their status alone may not say so.
+func (o *CreateOptions) waitCompletionMessage(name, status string, errorCount int) string {
+	defaults := ""
+	if o.Force && o.StorageLocation == "" {
+		defaults = " (using admin defaults)"
 	}
 
+	if errorCount > 0 {
+		noun := "errors"
+		if errorCount == 1 {
+			noun = "error"
+		}
+		return fmt.Sprintf("NonAdminBackup completed with %d %s (partial), status: %s%s. See what failed using the commands `oadp nonadmin backup describe %s` and `oadp nonadmin backup logs %s`.",
+			errorCount, noun, status, defaults, name, name)
+	}
+	return fmt.Sprintf("NonAdminBackup completed with status: %s%s. You may check for more information using the commands `oadp nonadmin backup describe %s` and `oadp nonadmin backup logs %s`.",
+		status, defaults, name, name)
+}
+
+// waitForNonAdminBackup waits until the Velero backup behind the NonAdminBackup finishes and
+// returns its status and error count. NonAdminBackup phases only track the request, so
+// completion comes from the Velero backup, but a request the controller rejects never gets
+// one: the rejection is returned as an error with the reason from the Accepted condition.
+func waitForNonAdminBackup(ctx context.Context, watchClient kbclient.WithWatch, namespace, name string, opts shared.WaitOptions) (string, int, error) {
+	var rejection string
+	var errorCount int
+	status, err := shared.WaitForPhase(ctx, watchClient, &nacv1alpha1.NonAdminBackupList{}, namespace, name,
+		func(backup *nacv1alpha1.NonAdminBackup) (bool, string) {
+			if reason, rejected := shared.NonAdminRejection(backup.Status.Conditions); rejected {
+				rejection = reason
+				return true, ""
+			}
+			if backup.Status.VeleroBackup != nil && backup.Status.VeleroBackup.Status != nil {
+				errorCount = backup.Status.VeleroBackup.Status.Errors
+			}
+			return shared.IsNonAdminBackupTerminal(backup), shared.NonAdminBackupStatus(backup)
+		},
+		opts,
+	)
+	if err != nil {
+		return "", 0, fmt.Errorf("error waiting for non-admin backup: %w", err)
+	}
+	if rejection != "" {
+		return "", 0, fmt.Errorf("NonAdminBackup %q was rejected: %s", name, rejection)
+	}
+	return status, errorCount, nil
+}
+
+// failedWaitStatuses are the --wait outcomes that make create exit non-zero, so scripts
+// and CI notice a backup that did not succeed
+var failedWaitStatuses = []string{
+	string(velerov1api.BackupPhaseFailed),
+	string(velerov1api.BackupPhasePartiallyFailed),
+	string(velerov1api.BackupPhaseFailedValidation),
+	string(nacv1alpha1.NonAdminPhaseBackingOff),
+}
+
+// checkWaitStatus returns an error if the backup finished the wait with a failed status
+func checkWaitStatus(name, status string) error {
+	if slices.Contains(failedWaitStatuses, status) {
+		return fmt.Errorf("NonAdminBackup %q finished with status %s", name, status)
+	}
 	return nil
 }
 
+// knownOutputVersions are the NonAdminBackup API versions --output-version accepts
+var knownOutputVersions = []string{nacv1alpha1.GroupVersion.Version}
+
+// resolveOutputVersion turns an --output-version of either "v1alpha1" or
+// "oadp.openshift.io/v1alpha1" into the apiVersion to print
+func resolveOutputVersion(version string) (string, error) {
+	gv, err := schema.ParseGroupVersion(version)
+	if err != nil {
+		return "", fmt.Errorf("invalid --output-version %q: %w", version, err)
+	}
+	if gv.Group == "" {
+		gv.Group = nacv1alpha1.GroupVersion.Group
+	}
+	if gv.Group != nacv1alpha1.GroupVersion.Group || !slices.Contains(knownOutputVersions, gv.Version) {
+		return "", fmt.Errorf("unknown --output-version %q, valid versions are: %s", version, strings.Join(knownOutputVersions, ", "))
+	}
+	return gv.String(), nil
+}
+
+// printFinishedBackup prints the NonAdminBackup as it is after --wait, honoring -o
+// and --output-version
+func (o *CreateOptions) printFinishedBackup(c *cobra.Command, name string) error {
+	ctx, cancel := shared.RequestContext()
+	defer cancel()
+
+	nab := &nacv1alpha1.NonAdminBackup{}
+	if err := o.client.Get(ctx, kbclient.ObjectKey{Namespace: o.currentNamespace, Name: name}, nab); err != nil {
+		return fmt.Errorf("failed to get NonAdminBackup %q: %w", name, err)
+	}
+
+	// Typed objects returned by the API server have no TypeMeta, which the encoder needs
+	nab.SetGroupVersionKind(nacv1alpha1.GroupVersion.WithKind("NonAdminBackup"))
+	if o.outputAPIVersion != "" {
+		nab.APIVersion = o.outputAPIVersion
+	}
+	_, err := printNonAdminBackupObject(c, nab)
+	return err
+}
+
+// printNonAdminBackupObject prints the NonAdminBackup for -o. It is the object Run would
+// submit, CLI-side defaults included, so piping it to `kubectl apply -f -` is equivalent.
+func printNonAdminBackupObject(c *cobra.Command, nonAdminBackup *nacv1alpha1.NonAdminBackup) (bool, error) {
+	switch format := output.GetOutputFlagValue(c); format {
+	case "json", "yaml":
+		return true, encode.To(nonAdminBackup, format, c.OutOrStdout())
+	}
+	return output.PrintWithFormat(c, nonAdminBackup)
+}
+
 // ParseOrderedResources converts to map of Kinds to an ordered list of specific resources of that Kind.
 // Resource names in the list are in format 'namespace/resourcename' and separated by commas.
 // Key-value pairs in the mapping are separated by semi-colon.
@@ -404,17 +917,27 @@ func ParseOrderedResources(orderMapStr string) (map[string]string, error) {
 func (o *CreateOptions) BuildNonAdminBackup(namespace string) (*nacv1alpha1.NonAdminBackup, error) {
 	// Create the underlying Velero BackupSpec
 	var backupSpec *velerov1api.BackupSpec
+	labels, annotations := o.Labels.Data(), o.Annotations.Data()
 
 	if o.FromSchedule != "" {
-		schedule := new(velerov1api.Schedule)
-		err := o.client.Get(context.TODO(), kbclient.ObjectKey{Namespace: namespace, Name: o.FromSchedule}, schedule)
-		if err != nil {
-			return nil, err
+		// Validate has already fetched the schedule when the command runs
+		schedule := o.schedule
+		if schedule == nil {
+			ctx, cancel := shared.RequestContext()
+			defer cancel()
+			schedule = new(velerov1api.Schedule)
+			if err := o.client.Get(ctx, kbclient.ObjectKey{Namespace: namespace, Name: o.FromSchedule}, schedule); err != nil {
+				return nil, err
+			}
 		}
 		if o.Name == "" {
 			o.Name = schedule.TimestampedName(time.Now().UTC())
 		}
 		backupSpec = &schedule.Spec.Template
+		if o.InheritMetadata {
+			labels = mergeMetadata(schedule.Labels, labels)
+			annotations = mergeMetadata(schedule.Annotations, annotations)
+		}
 	} else {
 		// Build the BackupSpec manually
 		// For NonAdminBackup, automatically include the current namespace
@@ -473,8 +996,8 @@ func (o *CreateOptions) BuildNonAdminBackup(namespace string) (*nacv1alpha1.NonA
 	// Create NonAdminBackup using the builder
 	nonAdminBackup := ForNonAdminBackup(namespace, o.Name).
 		ObjectMeta(
-			WithLabelsMap(o.Labels.Data()),
-			WithAnnotationsMap(o.Annotations.Data()),
+			WithLabelsMap(labels),
+			WithAnnotationsMap(annotations),
 		).
 		BackupSpec(nacv1alpha1.NonAdminBackupSpec{
 			BackupSpec: backupSpec,
@@ -484,6 +1007,16 @@ func (o *CreateOptions) BuildNonAdminBackup(namespace string) (*nacv1alpha1.NonA
 	return nonAdminBackup, nil
 }
 
+// mergeMetadata returns the inherited labels or annotations overlaid with the explicit ones
+func mergeMetadata(inherited, explicit map[string]string) map[string]string {
+	merged := maps.Clone(inherited)
+	if merged == nil {
+		merged = make(map[string]string, len(explicit))
+	}
+	maps.Copy(merged, explicit)
+	return merged
+}
+
 func (o *CreateOptions) oldAndNewFilterParametersUsedTogether() bool {
 	haveOldResourceFilterParameters := len(o.IncludeResources) > 0 ||
 		len(o.ExcludeResources) > 0 ||
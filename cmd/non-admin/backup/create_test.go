@@ -0,0 +1,1270 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	clienttesting "k8s.io/client-go/testing"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/migtools/oadp-cli/cmd/shared"
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
+	"github.com/vmware-tanzu/velero/pkg/util/encode"
+)
+
+// newFakeClient returns a fake client seeded with the given objects
+func newFakeClient(t *testing.T, objs ...kbclient.Object) kbclient.WithWatch {
+	t.Helper()
+
+	scheme, err := shared.NewSchemeWithTypes(shared.ClientOptions{
+		IncludeNonAdminTypes: true,
+		IncludeVeleroTypes:   true,
+		IncludeCoreTypes:     true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to build scheme: %v", err)
+	}
+
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).WithStatusSubresource(&nacv1alpha1.NonAdminBackup{}).Build()
+}
+
+// TestApprovedStorageLocationNames verifies --storage-location completion candidates
+func TestApprovedStorageLocationNames(t *testing.T) {
+	approved := &nacv1alpha1.NonAdminBackupStorageLocation{
+		ObjectMeta: metav1.ObjectMeta{Name: "approved-bsl", Namespace: "my-project"},
+		Status: nacv1alpha1.NonAdminBackupStorageLocationStatus{
+			Conditions: []metav1.Condition{{
+				Type:   string(nacv1alpha1.NonAdminBSLConditionApproved),
+				Status: metav1.ConditionTrue,
+			}},
+		},
+	}
+	created := &nacv1alpha1.NonAdminBackupStorageLocation{
+		ObjectMeta: metav1.ObjectMeta{Name: "another-bsl", Namespace: "my-project"},
+		Status:     nacv1alpha1.NonAdminBackupStorageLocationStatus{Phase: nacv1alpha1.NonAdminPhaseCreated},
+	}
+	pending := &nacv1alpha1.NonAdminBackupStorageLocation{
+		ObjectMeta: metav1.ObjectMeta{Name: "pending-bsl", Namespace: "my-project"},
+		Status:     nacv1alpha1.NonAdminBackupStorageLocationStatus{Phase: nacv1alpha1.NonAdminPhaseNew},
+	}
+	otherNamespace := &nacv1alpha1.NonAdminBackupStorageLocation{
+		ObjectMeta: metav1.ObjectMeta{Name: "foreign-bsl", Namespace: "other-project"},
+		Status:     nacv1alpha1.NonAdminBackupStorageLocationStatus{Phase: nacv1alpha1.NonAdminPhaseCreated},
+	}
+
+	kbClient := newFakeClient(t, approved, created, pending, otherNamespace)
+
+	names, err := approvedStorageLocationNames(context.Background(), kbClient, "my-project", "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if want := []string{"another-bsl", "approved-bsl"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("Expected %v, got %v", want, names)
+	}
+
+	names, err = approvedStorageLocationNames(context.Background(), kbClient, "my-project", "appr")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if want := []string{"approved-bsl"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("Expected %v for prefix, got %v", want, names)
+	}
+}
+
+// TestCreateIfNotExists verifies --if-not-exists turns an AlreadyExists error into a notice
+func TestCreateIfNotExists(t *testing.T) {
+	existing := &nacv1alpha1.NonAdminBackup{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-backup", Namespace: "my-project"},
+	}
+
+	for _, ifNotExists := range []bool{true, false} {
+		t.Run(fmt.Sprintf("if-not-exists=%t", ifNotExists), func(t *testing.T) {
+			o := NewCreateOptions()
+			o.Name = "my-backup"
+			o.StorageLocation = "my-nabsl"
+			o.IfNotExists = ifNotExists
+			o.currentNamespace = "my-project"
+			o.client = newFakeClient(t, existing)
+
+			c := &cobra.Command{}
+			output.BindFlags(c.Flags())
+			output.ClearOutputFlagDefault(c)
+			var out bytes.Buffer
+			c.SetOut(&out)
+
+			err := o.Run(c, nil)
+			if !ifNotExists {
+				if !apierrors.IsAlreadyExists(err) {
+					t.Fatalf("Run() error = %v, want AlreadyExists", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Run() error = %v", err)
+			}
+			if want := `NonAdminBackup "my-backup" already exists, skipping creation.`; !strings.Contains(out.String(), want) {
+				t.Errorf("expected output to contain %q, got:\n%s", want, out.String())
+			}
+		})
+	}
+}
+
+// TestCreateOutput verifies the submitted notice and the describe/logs hint are written to
+// the command's output
+func TestCreateOutput(t *testing.T) {
+	o := NewCreateOptions()
+	o.Name = "my-backup"
+	o.StorageLocation = "my-nabsl"
+	o.currentNamespace = "my-project"
+	o.client = newFakeClient(t)
+
+	c := &cobra.Command{}
+	output.BindFlags(c.Flags())
+	output.ClearOutputFlagDefault(c)
+	var out bytes.Buffer
+	c.SetOut(&out)
+
+	if err := o.Run(c, nil); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	for _, want := range []string{
+		`NonAdminBackup request "my-backup" submitted successfully.`,
+		"Run `oc oadp nonadmin backup describe my-backup` or `oc oadp nonadmin backup logs my-backup` for more details.",
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, out.String())
+		}
+	}
+}
+
+// TestCreateForceConfirmFlags verifies --confirm and --assume-yes/-y both skip the --force prompt
+func TestCreateForceConfirmFlags(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          []string
+		input         string
+		expectCreated bool
+	}{
+		{name: "--confirm", args: []string{"--force", "--confirm"}, expectCreated: true},
+		{name: "--assume-yes", args: []string{"--force", "--assume-yes"}, expectCreated: true},
+		{name: "-y", args: []string{"--force", "-y"}, expectCreated: true},
+		{name: "prompt answered no", args: []string{"--force"}, input: "n\n"},
+		{name: "prompt answered yes", args: []string{"--force"}, input: "y\n", expectCreated: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := NewCreateOptions()
+			flags := pflag.NewFlagSet("create", pflag.ContinueOnError)
+			o.BindFlags(flags)
+			if err := flags.Parse(tt.args); err != nil {
+				t.Fatalf("Parse(%v) error = %v", tt.args, err)
+			}
+			o.Name = "my-backup"
+			o.currentNamespace = "my-project"
+			fakeClient := newFakeClient(t)
+			o.client = fakeClient
+
+			c := &cobra.Command{}
+			output.BindFlags(c.Flags())
+			output.ClearOutputFlagDefault(c)
+			var out bytes.Buffer
+			c.SetOut(&out)
+			c.SetIn(strings.NewReader(tt.input))
+
+			if err := o.Run(c, nil); err != nil {
+				t.Fatalf("Run() error = %v", err)
+			}
+
+			prompted := strings.Contains(out.String(), "Do you want to continue?")
+			if skip := tt.input == ""; prompted == skip {
+				t.Errorf("expected prompt shown = %v, got:\n%s", !skip, out.String())
+			}
+
+			var nab nacv1alpha1.NonAdminBackup
+			err := fakeClient.Get(context.Background(), kbclient.ObjectKey{Namespace: "my-project", Name: "my-backup"}, &nab)
+			if tt.expectCreated && err != nil {
+				t.Errorf("expected the backup to be created, Get() error = %v", err)
+			}
+			if !tt.expectCreated && !apierrors.IsNotFound(err) {
+				t.Errorf("expected the backup not to be created, Get() error = %v", err)
+			}
+		})
+	}
+}
+
+// TestSnapshotMoveDataDefault verifies --snapshot-move-data defaults from the DPA's enforced
+// non-admin backup spec, and that an explicit flag always wins
+func TestSnapshotMoveDataDefault(t *testing.T) {
+	nabsl := &nacv1alpha1.NonAdminBackupStorageLocation{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-nabsl", Namespace: "my-project"},
+		Status: nacv1alpha1.NonAdminBackupStorageLocationStatus{
+			VeleroBackupStorageLocation: &nacv1alpha1.VeleroBackupStorageLocation{Namespace: "openshift-adp"},
+		},
+	}
+	dpa := func(enforced any) unstructured.Unstructured {
+		obj := unstructured.Unstructured{Object: map[string]any{}}
+		if enforced != nil {
+			_ = unstructured.SetNestedField(obj.Object, enforced, "spec", "nonAdmin", "enforceBackupSpec", "snapshotMoveData")
+		}
+		return obj
+	}
+	boolPtr := func(b bool) *bool { return &b }
+
+	tests := []struct {
+		name        string
+		explicit    *bool
+		dpas        []unstructured.Unstructured
+		listErr     error
+		expect      *bool
+		expectNote  bool
+		storageName string
+	}{
+		{
+			name:        "enforced by the admin",
+			dpas:        []unstructured.Unstructured{dpa(true)},
+			expect:      boolPtr(true),
+			expectNote:  true,
+			storageName: "my-nabsl",
+		},
+		{
+			name:        "explicit flag wins",
+			explicit:    boolPtr(false),
+			dpas:        []unstructured.Unstructured{dpa(true)},
+			expect:      boolPtr(false),
+			storageName: "my-nabsl",
+		},
+		{
+			name:        "not enforced",
+			dpas:        []unstructured.Unstructured{dpa(nil)},
+			storageName: "my-nabsl",
+		},
+		{
+			name:        "DPA not readable",
+			listErr:     apierrors.NewForbidden(schema.GroupResource{Group: "oadp.openshift.io", Resource: "dataprotectionapplications"}, "", fmt.Errorf("denied")),
+			storageName: "my-nabsl",
+		},
+		{
+			name:        "unknown storage location",
+			dpas:        []unstructured.Unstructured{dpa(true)},
+			storageName: "missing",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := interceptor.NewClient(newFakeClient(t, nabsl), interceptor.Funcs{
+				List: func(ctx context.Context, c kbclient.WithWatch, list kbclient.ObjectList, opts ...kbclient.ListOption) error {
+					if u, ok := list.(*unstructured.UnstructuredList); ok && u.GroupVersionKind() == dpaListGVK {
+						if tt.listErr != nil {
+							return tt.listErr
+						}
+						u.Items = tt.dpas
+						return nil
+					}
+					return c.List(ctx, list, opts...)
+				},
+			})
+
+			o := NewCreateOptions()
+			o.StorageLocation = tt.storageName
+			o.SnapshotMoveData.Value = tt.explicit
+			o.currentNamespace = "my-project"
+			o.client = client
+
+			var out bytes.Buffer
+			o.applySnapshotMoveDataDefault(context.Background(), &out)
+
+			if !reflect.DeepEqual(o.SnapshotMoveData.Value, tt.expect) {
+				t.Errorf("SnapshotMoveData = %v, want %v", o.SnapshotMoveData.Value, tt.expect)
+			}
+			if hasNote := strings.Contains(out.String(), "defaulting --snapshot-move-data=true"); hasNote != tt.expectNote {
+				t.Errorf("expected note = %v, output:\n%s", tt.expectNote, out.String())
+			}
+		})
+	}
+}
+
+// TestValidateDataMover verifies --data-mover only accepts known movers unless forced
+func TestValidateDataMover(t *testing.T) {
+	nabsl := &nacv1alpha1.NonAdminBackupStorageLocation{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-nabsl", Namespace: "my-project"},
+		Status: nacv1alpha1.NonAdminBackupStorageLocationStatus{
+			VeleroBackupStorageLocation: &nacv1alpha1.VeleroBackupStorageLocation{Namespace: "openshift-adp"},
+		},
+	}
+	dpa := unstructured.Unstructured{Object: map[string]any{}}
+	_ = unstructured.SetNestedField(dpa.Object, "custom-mover", "spec", "nonAdmin", "enforceBackupSpec", "dataMover")
+
+	client := interceptor.NewClient(newFakeClient(t, nabsl), interceptor.Funcs{
+		List: func(ctx context.Context, c kbclient.WithWatch, list kbclient.ObjectList, opts ...kbclient.ListOption) error {
+			if u, ok := list.(*unstructured.UnstructuredList); ok && u.GroupVersionKind() == dpaListGVK {
+				u.Items = []unstructured.Unstructured{dpa}
+				return nil
+			}
+			return c.List(ctx, list, opts...)
+		},
+	})
+
+	tests := []struct {
+		name        string
+		dataMover   string
+		force       bool
+		expectError string
+	}{
+		{name: "not set", dataMover: ""},
+		{name: "built-in mover", dataMover: "velero"},
+		{name: "mover from the DPA", dataMover: "custom-mover"},
+		{name: "typo", dataMover: "kopa", expectError: `unknown data mover "kopa", valid options are: custom-mover, velero`},
+		{name: "unknown mover forced", dataMover: "kopa", force: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := NewCreateOptions()
+			o.StorageLocation = "my-nabsl"
+			o.DataMover = tt.dataMover
+			o.ForceDataMover = tt.force
+			o.currentNamespace = "my-project"
+			o.client = client
+
+			err := o.validateDataMover(context.Background())
+			if tt.expectError == "" {
+				if err != nil {
+					t.Errorf("validateDataMover() unexpected error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+				t.Errorf("validateDataMover() error = %v, want it to contain %q", err, tt.expectError)
+			}
+		})
+	}
+}
+
+// TestWarnTTLNotEnforced verifies the rejection warning fires when --ttl differs from
+// the TTL enforced in the DPA, whether it is longer or shorter
+func TestWarnTTLNotEnforced(t *testing.T) {
+	nabsl := &nacv1alpha1.NonAdminBackupStorageLocation{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-nabsl", Namespace: "my-project"},
+		Status: nacv1alpha1.NonAdminBackupStorageLocationStatus{
+			VeleroBackupStorageLocation: &nacv1alpha1.VeleroBackupStorageLocation{Namespace: "openshift-adp"},
+		},
+	}
+	dpa := unstructured.Unstructured{Object: map[string]any{}}
+	_ = unstructured.SetNestedField(dpa.Object, "720h0m0s", "spec", "nonAdmin", "enforceBackupSpec", "ttl")
+
+	client := interceptor.NewClient(newFakeClient(t, nabsl), interceptor.Funcs{
+		List: func(ctx context.Context, c kbclient.WithWatch, list kbclient.ObjectList, opts ...kbclient.ListOption) error {
+			if u, ok := list.(*unstructured.UnstructuredList); ok && u.GroupVersionKind() == dpaListGVK {
+				u.Items = []unstructured.Unstructured{dpa}
+				return nil
+			}
+			return c.List(ctx, list, opts...)
+		},
+	})
+
+	tests := []struct {
+		name            string
+		ttl             time.Duration
+		storageLocation string
+		expectWarning   string
+	}{
+		{name: "not set", storageLocation: "my-nabsl"},
+		{name: "matches the enforced TTL", ttl: 720 * time.Hour, storageLocation: "my-nabsl"},
+		{name: "shorter than the enforced TTL", ttl: 24 * time.Hour, storageLocation: "my-nabsl", expectWarning: "24h0m0s"},
+		{name: "longer than the enforced TTL", ttl: 1000 * time.Hour, storageLocation: "my-nabsl", expectWarning: "1000h0m0s"},
+		{name: "enforcement not readable", ttl: 1000 * time.Hour, storageLocation: "other-nabsl"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := NewCreateOptions()
+			o.TTL = tt.ttl
+			o.StorageLocation = tt.storageLocation
+			o.currentNamespace = "my-project"
+			o.client = client
+
+			var out bytes.Buffer
+			o.warnTTLNotEnforced(context.Background(), &out)
+
+			want := ""
+			if tt.expectWarning != "" {
+				want = `Warning: --ttl ` + tt.expectWarning + ` differs from the TTL of 720h0m0s the OADP admin enforces for backups to "my-nabsl"; the backup will be rejected. Omit --ttl to use the enforced value.`
+			}
+			if got := strings.TrimSpace(out.String()); got != want {
+				t.Errorf("warnTTLNotEnforced() output = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+// TestCreateOutputMatchesSubmitted verifies -o yaml prints exactly the object create submits,
+// including the auto-included namespace and defaults resolved by the CLI
+func TestCreateOutputMatchesSubmitted(t *testing.T) {
+	nabsl := &nacv1alpha1.NonAdminBackupStorageLocation{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-nabsl", Namespace: "my-project"},
+		Status: nacv1alpha1.NonAdminBackupStorageLocationStatus{
+			VeleroBackupStorageLocation: &nacv1alpha1.VeleroBackupStorageLocation{Namespace: "openshift-adp"},
+		},
+	}
+	dpa := unstructured.Unstructured{Object: map[string]any{}}
+	_ = unstructured.SetNestedField(dpa.Object, true, "spec", "nonAdmin", "enforceBackupSpec", "snapshotMoveData")
+
+	var submitted *nacv1alpha1.NonAdminBackup
+	client := interceptor.NewClient(newFakeClient(t, nabsl), interceptor.Funcs{
+		Create: func(ctx context.Context, c kbclient.WithWatch, obj kbclient.Object, opts ...kbclient.CreateOption) error {
+			if nab, ok := obj.(*nacv1alpha1.NonAdminBackup); ok {
+				submitted = nab.DeepCopy()
+			}
+			return c.Create(ctx, obj, opts...)
+		},
+		List: func(ctx context.Context, c kbclient.WithWatch, list kbclient.ObjectList, opts ...kbclient.ListOption) error {
+			if u, ok := list.(*unstructured.UnstructuredList); ok && u.GroupVersionKind() == dpaListGVK {
+				u.Items = []unstructured.Unstructured{dpa}
+				return nil
+			}
+			return c.List(ctx, list, opts...)
+		},
+	})
+
+	o := NewCreateOptions()
+	o.Name = "my-backup"
+	o.StorageLocation = "my-nabsl"
+	o.currentNamespace = "my-project"
+	o.client = client
+	o.applySnapshotMoveDataDefault(context.Background(), &bytes.Buffer{})
+
+	run := func(format string) string {
+		c := &cobra.Command{}
+		output.BindFlags(c.Flags())
+		output.ClearOutputFlagDefault(c)
+		if format != "" {
+			if err := c.Flags().Set("output", format); err != nil {
+				t.Fatalf("Failed to set output: %v", err)
+			}
+		}
+		var out bytes.Buffer
+		c.SetOut(&out)
+
+		if err := o.Run(c, nil); err != nil {
+			t.Fatalf("Run() error = %v", err)
+		}
+		return out.String()
+	}
+
+	printed := run("yaml")
+	if submitted != nil {
+		t.Fatalf("-o yaml should not create the backup")
+	}
+	run("")
+	if submitted == nil {
+		t.Fatalf("expected the backup to be created")
+	}
+
+	var expected bytes.Buffer
+	if err := encode.To(submitted, "yaml", &expected); err != nil {
+		t.Fatalf("Failed to encode submitted object: %v", err)
+	}
+	if printed != expected.String() {
+		t.Errorf("-o yaml differs from the submitted object:\nprinted:\n%s\nsubmitted:\n%s", printed, expected.String())
+	}
+	for _, want := range []string{"kind: NonAdminBackup", "- my-project", "snapshotMoveData: true", "storageLocation: my-nabsl"} {
+		if !strings.Contains(printed, want) {
+			t.Errorf("expected -o yaml to contain %q, got:\n%s", want, printed)
+		}
+	}
+}
+
+// TestCreateDryRunServer verifies --dry-run=server threads DryRunAll into Create and
+// prints the returned object in the -o format, yaml by default, without persisting it
+func TestCreateDryRunServer(t *testing.T) {
+	tests := []struct {
+		name   string
+		args   []string
+		expect []string
+	}{
+		{name: "yaml by default", expect: []string{"kind: NonAdminBackup", "storageLocation: my-nabsl"}},
+		{name: "-o json", args: []string{"-o", "json"}, expect: []string{`"kind": "NonAdminBackup"`, `"storageLocation": "my-nabsl"`}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var createOpts kbclient.CreateOptions
+			client := interceptor.NewClient(newFakeClient(t), interceptor.Funcs{
+				Create: func(ctx context.Context, c kbclient.WithWatch, obj kbclient.Object, opts ...kbclient.CreateOption) error {
+					createOpts.ApplyOptions(opts)
+					return c.Create(ctx, obj, opts...)
+				},
+			})
+
+			o := NewCreateOptions()
+			o.Name = "my-backup"
+			o.StorageLocation = "my-nabsl"
+			o.DryRun = shared.DryRunServer
+			o.currentNamespace = "my-project"
+			o.client = client
+
+			c := &cobra.Command{}
+			output.BindFlags(c.Flags())
+			output.ClearOutputFlagDefault(c)
+			if err := c.Flags().Parse(tt.args); err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			var out bytes.Buffer
+			c.SetOut(&out)
+
+			if err := o.Run(c, nil); err != nil {
+				t.Fatalf("Run() error = %v", err)
+			}
+
+			if len(createOpts.DryRun) != 1 || createOpts.DryRun[0] != metav1.DryRunAll {
+				t.Errorf("expected Create to be called with DryRunAll, got %v", createOpts.DryRun)
+			}
+			for _, want := range tt.expect {
+				if !strings.Contains(out.String(), want) {
+					t.Errorf("expected output to contain %q, got:\n%s", want, out.String())
+				}
+			}
+
+			var nab nacv1alpha1.NonAdminBackup
+			err := client.Get(context.Background(), kbclient.ObjectKey{Namespace: "my-project", Name: "my-backup"}, &nab)
+			if !apierrors.IsNotFound(err) {
+				t.Errorf("expected the dry run not to persist the backup, Get() error = %v", err)
+			}
+		})
+	}
+}
+
+// TestCreateCommandRun runs `backup create` end to end through cobra against a fake client
+func TestCreateCommandRun(t *testing.T) {
+	kubeconfig := filepath.Join(t.TempDir(), "config")
+	if err := os.WriteFile(kubeconfig, []byte(`apiVersion: v1
+kind: Config
+current-context: dev
+clusters:
+- name: dev
+  cluster:
+    server: https://dev.example.com
+contexts:
+- name: dev
+  context:
+    cluster: dev
+    namespace: my-project
+`), 0600); err != nil {
+		t.Fatalf("Failed to write kubeconfig: %v", err)
+	}
+	t.Setenv("KUBECONFIG", kubeconfig)
+
+	scheme, err := shared.NewSchemeWithTypes(shared.ClientOptions{IncludeNonAdminTypes: true})
+	if err != nil {
+		t.Fatalf("Failed to build scheme: %v", err)
+	}
+	// The backup command group checks the REST mapper for the NAC CRDs before running
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(nacv1alpha1.GroupVersion.WithKind("NonAdminBackup"), meta.RESTScopeNamespace)
+	client := fake.NewClientBuilder().WithScheme(scheme).WithRESTMapper(mapper).Build()
+	shared.UseFakeClient(t, client)
+
+	c := NewBackupCommand(nil)
+	c.SetArgs([]string{"create", "my-backup", "--storage-location", "my-nabsl", "--include-resources", "deployments"})
+	if err := c.Execute(); err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	var nab nacv1alpha1.NonAdminBackup
+	if err := client.Get(context.Background(), kbclient.ObjectKey{Namespace: "my-project", Name: "my-backup"}, &nab); err != nil {
+		t.Fatalf("expected the backup to be created: %v", err)
+	}
+	spec := nab.Spec.BackupSpec
+	if spec == nil || spec.StorageLocation != "my-nabsl" || !reflect.DeepEqual(spec.IncludedNamespaces, []string{"my-project"}) || !reflect.DeepEqual(spec.IncludedResources, []string{"deployments"}) {
+		t.Errorf("unexpected backup spec: %+v", spec)
+	}
+}
+
+// TestCreateFromSchedule verifies a missing schedule is reported by Validate, and that the
+// backup name derived from the schedule is used and shown
+func TestCreateFromSchedule(t *testing.T) {
+	schedule := &velerov1api.Schedule{
+		ObjectMeta: metav1.ObjectMeta{Name: "nightly", Namespace: "my-project"},
+		Spec: velerov1api.ScheduleSpec{
+			Template: velerov1api.BackupSpec{StorageLocation: "my-nabsl"},
+		},
+	}
+
+	newCommand := func(o *CreateOptions) (*cobra.Command, *bytes.Buffer) {
+		c := &cobra.Command{}
+		o.BindFromSchedule(c.Flags())
+		output.BindFlags(c.Flags())
+		output.ClearOutputFlagDefault(c)
+		var out bytes.Buffer
+		c.SetOut(&out)
+		return c, &out
+	}
+
+	t.Run("missing schedule", func(t *testing.T) {
+		o := NewCreateOptions()
+		c, _ := newCommand(o)
+		o.FromSchedule = "weekly"
+		o.Force = true
+		o.currentNamespace = "my-project"
+		o.client = newFakeClient(t, schedule)
+
+		err := o.Validate(c, nil, nil)
+		if want := `schedule "weekly" not found in namespace "my-project"`; err == nil || err.Error() != want {
+			t.Errorf("Validate() error = %v, want %q", err, want)
+		}
+	})
+
+	t.Run("derived name", func(t *testing.T) {
+		client := newFakeClient(t, schedule)
+		o := NewCreateOptions()
+		c, out := newCommand(o)
+		o.FromSchedule = "nightly"
+		o.Force = true
+		o.AssumeYes = true
+		o.currentNamespace = "my-project"
+		o.client = client
+
+		if err := o.Validate(c, nil, nil); err != nil {
+			t.Fatalf("Validate() error = %v", err)
+		}
+		if err := o.Run(c, nil); err != nil {
+			t.Fatalf("Run() error = %v", err)
+		}
+
+		var list nacv1alpha1.NonAdminBackupList
+		if err := client.List(context.Background(), &list); err != nil {
+			t.Fatalf("List() error = %v", err)
+		}
+		if len(list.Items) != 1 || !strings.HasPrefix(list.Items[0].Name, "nightly-") {
+			t.Fatalf("expected one backup named after the schedule, got %+v", list.Items)
+		}
+		if list.Items[0].Spec.BackupSpec.StorageLocation != "my-nabsl" {
+			t.Errorf("expected the schedule's template to be used, got %+v", list.Items[0].Spec.BackupSpec)
+		}
+		if want := fmt.Sprintf("Using backup name %q derived from schedule %q.", list.Items[0].Name, "nightly"); !strings.Contains(out.String(), want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, out.String())
+		}
+	})
+}
+
+// TestOutputVersion verifies --output-version rewrites the printed apiVersion and rejects
+// unknown versions
+func TestOutputVersion(t *testing.T) {
+	// Simulate a newer NAC API the CLI knows about
+	knownOutputVersions = append(knownOutputVersions, "v1beta1")
+	t.Cleanup(func() { knownOutputVersions = knownOutputVersions[:len(knownOutputVersions)-1] })
+
+	tests := []struct {
+		name          string
+		format        string
+		outputVersion string
+		expectVersion string
+		expectError   string
+	}{
+		{name: "default", format: "yaml", expectVersion: "apiVersion: oadp.openshift.io/v1alpha1"},
+		{name: "version only", format: "yaml", outputVersion: "v1beta1", expectVersion: "apiVersion: oadp.openshift.io/v1beta1"},
+		{name: "group and version", format: "json", outputVersion: "oadp.openshift.io/v1beta1", expectVersion: `"apiVersion": "oadp.openshift.io/v1beta1"`},
+		{name: "unknown version", format: "yaml", outputVersion: "v2", expectError: `unknown --output-version "v2", valid versions are: v1alpha1, v1beta1`},
+		{name: "other group", format: "yaml", outputVersion: "velero.io/v1", expectError: `unknown --output-version "velero.io/v1"`},
+		{name: "without -o", outputVersion: "v1beta1", expectError: "--output-version can only be used with -o json or -o yaml"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := NewCreateOptions()
+			c := &cobra.Command{}
+			o.BindFlags(c.Flags())
+			output.BindFlags(c.Flags())
+			output.ClearOutputFlagDefault(c)
+			if tt.format != "" {
+				if err := c.Flags().Set("output", tt.format); err != nil {
+					t.Fatalf("Failed to set output: %v", err)
+				}
+			}
+			var out bytes.Buffer
+			c.SetOut(&out)
+
+			o.OutputVersion = tt.outputVersion
+			o.StorageLocation = "my-nabsl"
+			o.currentNamespace = "my-project"
+			o.client = newFakeClient(t)
+
+			err := o.Validate(c, []string{"my-backup"}, nil)
+			if tt.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+					t.Fatalf("Validate() error = %v, want it to contain %q", err, tt.expectError)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Validate() error = %v", err)
+			}
+
+			o.Name = "my-backup"
+			if err := o.Run(c, nil); err != nil {
+				t.Fatalf("Run() error = %v", err)
+			}
+			if !strings.Contains(out.String(), tt.expectVersion) {
+				t.Errorf("expected output to contain %q, got:\n%s", tt.expectVersion, out.String())
+			}
+		})
+	}
+}
+
+// TestWaitForNonAdminBackupRejected verifies waiting stops with the reason as soon as the
+// controller rejects the backup, instead of waiting for a Velero backup that never comes
+func TestWaitForNonAdminBackupRejected(t *testing.T) {
+	nab := &nacv1alpha1.NonAdminBackup{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-backup", Namespace: "my-project"},
+		Status:     nacv1alpha1.NonAdminBackupStatus{Phase: nacv1alpha1.NonAdminPhaseNew},
+	}
+	client := newFakeClient(t, nab)
+
+	// Update after the informer's initial list, so the rejection arrives through its watch
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		nab.Status.Conditions = []metav1.Condition{{
+			Type:               string(nacv1alpha1.NonAdminConditionAccepted),
+			Status:             metav1.ConditionFalse,
+			Reason:             "InvalidBackupSpec",
+			Message:            "NonAdminBackup spec.backupSpec.ttl field value is enforced by admin",
+			LastTransitionTime: metav1.Now(),
+		}}
+		if err := client.Status().Update(context.Background(), nab); err != nil {
+			t.Errorf("Failed to update %s: %v", nab.Name, err)
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, _, err := waitForNonAdminBackup(ctx, client, "my-project", "my-backup", shared.WaitOptions{})
+	want := `NonAdminBackup "my-backup" was rejected: InvalidBackupSpec: NonAdminBackup spec.backupSpec.ttl field value is enforced by admin`
+	if err == nil || err.Error() != want {
+		t.Errorf("waitForNonAdminBackup() error = %v, want %q", err, want)
+	}
+}
+
+// finishBackupWhenCreated moves my-backup in my-project to the given Velero status
+// shortly after it is created, e.g. by a create --wait running concurrently
+func finishBackupWhenCreated(t *testing.T, client kbclient.Client, status velerov1api.BackupStatus) {
+	t.Helper()
+
+	go func() {
+		key := kbclient.ObjectKey{Namespace: "my-project", Name: "my-backup"}
+		for {
+			nab := &nacv1alpha1.NonAdminBackup{}
+			if err := client.Get(context.Background(), key, nab); err != nil {
+				time.Sleep(10 * time.Millisecond)
+				continue
+			}
+			// Update after the informer's initial list, so the change arrives through its watch
+			time.Sleep(50 * time.Millisecond)
+			nab.Status.Phase = nacv1alpha1.NonAdminPhaseCreated
+			nab.Status.VeleroBackup = &nacv1alpha1.VeleroBackup{
+				Name:   "nab-my-backup",
+				Status: &status,
+			}
+			if err := client.Status().Update(context.Background(), nab); err != nil {
+				t.Errorf("Failed to update %s: %v", nab.Name, err)
+			}
+			return
+		}
+	}()
+}
+
+// TestCreateWaitOutput verifies --wait -o yaml creates the backup, prints the finished backup
+// as the only stdout output and reports progress on stderr
+func TestCreateWaitOutput(t *testing.T) {
+	client := newFakeClient(t)
+	finishBackupWhenCreated(t, client, velerov1api.BackupStatus{Phase: velerov1api.BackupPhaseCompleted})
+
+	o := NewCreateOptions()
+	o.Name = "my-backup"
+	o.StorageLocation = "my-nabsl"
+	o.Wait = true
+	o.currentNamespace = "my-project"
+	o.client = client
+
+	c := &cobra.Command{}
+	output.BindFlags(c.Flags())
+	output.ClearOutputFlagDefault(c)
+	if err := c.Flags().Set("output", "yaml"); err != nil {
+		t.Fatalf("Set(output) error = %v", err)
+	}
+	var stdout, stderr bytes.Buffer
+	c.SetOut(&stdout)
+	c.SetErr(&stderr)
+
+	if err := o.Run(c, nil); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	var printed nacv1alpha1.NonAdminBackup
+	if err := yaml.Unmarshal(stdout.Bytes(), &printed); err != nil {
+		t.Fatalf("expected stdout to be YAML only, got error %v for:\n%s", err, stdout.String())
+	}
+	if printed.Name != "my-backup" || printed.Status.Phase != nacv1alpha1.NonAdminPhaseCreated {
+		t.Errorf("expected the finished backup on stdout, got:\n%s", stdout.String())
+	}
+	for _, want := range []string{"submitted successfully", "Waiting for non-admin backup to complete", "completed with status: Completed"} {
+		if !strings.Contains(stderr.String(), want) {
+			t.Errorf("expected stderr to contain %q, got:\n%s", want, stderr.String())
+		}
+	}
+}
+
+// TestCreateWaitFailedStatus verifies create --wait returns an error, and so exits non-zero,
+// when the backup finishes in a failed phase
+func TestCreateWaitFailedStatus(t *testing.T) {
+	for _, phase := range []velerov1api.BackupPhase{velerov1api.BackupPhaseFailed, velerov1api.BackupPhasePartiallyFailed, velerov1api.BackupPhaseCompleted} {
+		t.Run(string(phase), func(t *testing.T) {
+			client := newFakeClient(t)
+			finishBackupWhenCreated(t, client, velerov1api.BackupStatus{Phase: phase})
+
+			o := NewCreateOptions()
+			o.Name = "my-backup"
+			o.Force = true
+			o.AssumeYes = true
+			o.Wait = true
+			o.currentNamespace = "my-project"
+			o.client = client
+
+			c := &cobra.Command{}
+			output.BindFlags(c.Flags())
+			output.ClearOutputFlagDefault(c)
+			c.SetOut(io.Discard)
+			c.SetErr(io.Discard)
+
+			err := o.Run(c, nil)
+			if phase == velerov1api.BackupPhaseCompleted {
+				if err != nil {
+					t.Errorf("Run() error = %v", err)
+				}
+				return
+			}
+			if want := fmt.Sprintf(`NonAdminBackup "my-backup" finished with status %s`, phase); err == nil || err.Error() != want {
+				t.Errorf("Run() error = %v, want %q", err, want)
+			}
+		})
+	}
+}
+
+// TestCreateWaitPartialFailure verifies --wait calls out a backup that finished with errors
+// as partial and points at describe and logs, unlike a clean completion
+func TestCreateWaitPartialFailure(t *testing.T) {
+	tests := []struct {
+		name   string
+		status velerov1api.BackupStatus
+		want   string
+	}{
+		{
+			name:   "clean",
+			status: velerov1api.BackupStatus{Phase: velerov1api.BackupPhaseCompleted},
+			want:   "NonAdminBackup completed with status: Completed.",
+		},
+		{
+			name:   "partial",
+			status: velerov1api.BackupStatus{Phase: velerov1api.BackupPhasePartiallyFailed, Errors: 3},
+			want:   "NonAdminBackup completed with 3 errors (partial), status: PartiallyFailed. See what failed using the commands `oadp nonadmin backup describe my-backup` and `oadp nonadmin backup logs my-backup`.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newFakeClient(t)
+			finishBackupWhenCreated(t, client, tt.status)
+
+			o := NewCreateOptions()
+			o.Name = "my-backup"
+			o.StorageLocation = "my-nabsl"
+			o.Wait = true
+			o.currentNamespace = "my-project"
+			o.client = client
+
+			c := &cobra.Command{}
+			output.BindFlags(c.Flags())
+			output.ClearOutputFlagDefault(c)
+			var stderr bytes.Buffer
+			c.SetOut(io.Discard)
+			c.SetErr(&stderr)
+
+			_ = o.Run(c, nil)
+			if !strings.Contains(stderr.String(), tt.want) {
+				t.Errorf("expected stderr to contain %q, got:\n%s", tt.want, stderr.String())
+			}
+		})
+	}
+}
+
+// TestInheritMetadata verifies --inherit-metadata copies the schedule's labels and annotations,
+// with --labels and --annotations taking precedence
+func TestInheritMetadata(t *testing.T) {
+	schedule := &velerov1api.Schedule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "nightly",
+			Namespace:   "my-project",
+			Labels:      map[string]string{"team": "payments", "env": "dev"},
+			Annotations: map[string]string{"owner": "alice"},
+		},
+	}
+
+	for _, inherit := range []bool{true, false} {
+		t.Run(fmt.Sprintf("inherit-metadata=%t", inherit), func(t *testing.T) {
+			o := NewCreateOptions()
+			o.FromSchedule = "nightly"
+			o.InheritMetadata = inherit
+			o.client = newFakeClient(t, schedule)
+			if err := o.Labels.Set("env=prod"); err != nil {
+				t.Fatalf("Labels.Set() error = %v", err)
+			}
+
+			nab, err := o.BuildNonAdminBackup("my-project")
+			if err != nil {
+				t.Fatalf("BuildNonAdminBackup() error = %v", err)
+			}
+
+			wantLabels := map[string]string{"env": "prod"}
+			wantAnnotations := map[string]string{}
+			if inherit {
+				wantLabels = map[string]string{"team": "payments", "env": "prod"}
+				wantAnnotations = map[string]string{"owner": "alice"}
+			}
+			if !reflect.DeepEqual(nab.Labels, wantLabels) {
+				t.Errorf("labels = %v, want %v", nab.Labels, wantLabels)
+			}
+			if !reflect.DeepEqual(nab.Annotations, wantAnnotations) {
+				t.Errorf("annotations = %v, want %v", nab.Annotations, wantAnnotations)
+			}
+		})
+	}
+}
+
+// TestValidateParallelFilesUpload verifies --parallel-files-upload warns, or fails with
+// --strict, unless the backup uses the kopia uploader
+func TestValidateParallelFilesUpload(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          []string
+		expectWarning bool
+		expectError   string
+	}{
+		{name: "not set", args: nil},
+		{name: "fs-backup", args: []string{"--parallel-files-upload=4", "--default-volumes-to-fs-backup"}},
+		{name: "data mover", args: []string{"--parallel-files-upload=4", "--snapshot-move-data"}},
+		{name: "ignored", args: []string{"--parallel-files-upload=4"}, expectWarning: true},
+		{name: "fs-backup disabled", args: []string{"--parallel-files-upload=4", "--default-volumes-to-fs-backup=false"}, expectWarning: true},
+		{
+			name:        "strict",
+			args:        []string{"--parallel-files-upload=4", "--strict"},
+			expectError: "--parallel-files-upload only applies to the kopia uploader",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := NewCreateOptions()
+			c := &cobra.Command{}
+			o.BindFlags(c.Flags())
+			if err := c.Flags().Parse(tt.args); err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			var out bytes.Buffer
+			err := o.validateParallelFilesUpload(&out)
+			if tt.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+					t.Errorf("validateParallelFilesUpload() error = %v, want it to contain %q", err, tt.expectError)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("validateParallelFilesUpload() error = %v", err)
+			}
+			if warned := strings.Contains(out.String(), "will be ignored"); warned != tt.expectWarning {
+				t.Errorf("expected warning: %t, got output %q", tt.expectWarning, out.String())
+			}
+		})
+	}
+}
+
+// TestValidateSnapshotVolumes verifies that explicitly enabling both --snapshot-volumes and
+// --default-volumes-to-fs-backup warns, or fails with --strict
+func TestValidateSnapshotVolumes(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          []string
+		expectWarning bool
+		expectError   string
+	}{
+		{name: "neither set", args: nil},
+		{name: "snapshot volumes only", args: []string{"--snapshot-volumes"}},
+		{name: "fs-backup only", args: []string{"--default-volumes-to-fs-backup"}},
+		{name: "snapshots disabled", args: []string{"--snapshot-volumes=false", "--default-volumes-to-fs-backup"}},
+		{name: "both set", args: []string{"--snapshot-volumes", "--default-volumes-to-fs-backup"}, expectWarning: true},
+		{
+			name:        "both set strict",
+			args:        []string{"--snapshot-volumes=true", "--default-volumes-to-fs-backup=true", "--strict"},
+			expectError: "--snapshot-volumes and --default-volumes-to-fs-backup conflict",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := NewCreateOptions()
+			c := &cobra.Command{}
+			o.BindFlags(c.Flags())
+			if err := c.Flags().Parse(tt.args); err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			var out bytes.Buffer
+			err := o.validateSnapshotVolumes(&out)
+			if tt.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+					t.Errorf("validateSnapshotVolumes() error = %v, want it to contain %q", err, tt.expectError)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("validateSnapshotVolumes() error = %v", err)
+			}
+			if warned := strings.Contains(out.String(), "Warning: --snapshot-volumes"); warned != tt.expectWarning {
+				t.Errorf("expected warning: %t, got output %q", tt.expectWarning, out.String())
+			}
+		})
+	}
+}
+
+// TestValidateReservedKeys verifies --labels and --annotations reject keys under the
+// reserved prefixes, which --reserved-key-prefixes can change
+func TestValidateReservedKeys(t *testing.T) {
+	tests := []struct {
+		name        string
+		args        []string
+		expectError string
+	}{
+		{name: "benign label", args: []string{"--labels", "app=payments", "--annotations", "owner=alice"}},
+		{
+			name:        "reserved label",
+			args:        []string{"--labels", "app=payments,velero.io/backup-name=other"},
+			expectError: `--labels key "velero.io/backup-name" uses the reserved prefix "velero.io/"`,
+		},
+		{
+			name:        "reserved annotation",
+			args:        []string{"--annotations", "openshift.io/oadp-nab-origin-name=other"},
+			expectError: `--annotations key "openshift.io/oadp-nab-origin-name" uses the reserved prefix "openshift.io/oadp"`,
+		},
+		{
+			name: "custom prefixes",
+			args: []string{"--labels", "velero.io/backup-name=other", "--reserved-key-prefixes", "example.com/"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := NewCreateOptions()
+			c := &cobra.Command{}
+			o.BindFlags(c.Flags())
+			if err := c.Flags().Parse(tt.args); err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			err := o.validateReservedKeys()
+			if tt.expectError == "" {
+				if err != nil {
+					t.Errorf("validateReservedKeys() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+				t.Errorf("validateReservedKeys() error = %v, want it to contain %q", err, tt.expectError)
+			}
+		})
+	}
+}
+
+// TestValidateResources verifies --validate-resources accepts the spellings Velero resolves
+// and suggests close matches for resources the cluster doesn't serve
+func TestValidateResources(t *testing.T) {
+	discoveryClient := &fakediscovery.FakeDiscovery{Fake: &clienttesting.Fake{Resources: []*metav1.APIResourceList{
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "configmaps", SingularName: "configmap", Kind: "ConfigMap", ShortNames: []string{"cm"}},
+				{Name: "pods", SingularName: "pod", Kind: "Pod", ShortNames: []string{"po"}},
+				{Name: "pods/log", Kind: "Pod"},
+			},
+		},
+		{
+			GroupVersion: "apps/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "deployments", SingularName: "deployment", Kind: "Deployment", ShortNames: []string{"deploy"}},
+			},
+		},
+	}}}
+
+	tests := []struct {
+		name        string
+		args        []string
+		expectError string
+	}{
+		{name: "all resources"},
+		{name: "served resources", args: []string{"--include-resources", "deployments.apps,deployment,cm,Pods", "--exclude-resources", "configmaps"}},
+		{
+			name:        "near miss",
+			args:        []string{"--include-resources", "deployment.app"},
+			expectError: `--include-resources: resource "deployment.app" is not served by the cluster; did you mean 'deployments.apps'?`,
+		},
+		{
+			name:        "scoped filter",
+			args:        []string{"--include-namespace-scoped-resources", "pods,configmap", "--exclude-namespace-scoped-resources", "pds"},
+			expectError: `--exclude-namespace-scoped-resources: resource "pds" is not served by the cluster; did you mean 'pods'?`,
+		},
+		{
+			name:        "no close match",
+			args:        []string{"--exclude-resources", "widgets.example.com"},
+			expectError: `--exclude-resources: resource "widgets.example.com" is not served by the cluster`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := NewCreateOptions()
+			c := &cobra.Command{}
+			o.BindFlags(c.Flags())
+			if err := c.Flags().Parse(tt.args); err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			o.discoveryClient = discoveryClient
+
+			err := o.validateResources()
+			if tt.expectError == "" {
+				if err != nil {
+					t.Errorf("validateResources() error = %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.expectError {
+				t.Errorf("validateResources() error = %v, want %q", err, tt.expectError)
+			}
+		})
+	}
+}
+
+// TestApplyBackupDefaults verifies the backup defaults file fills in flags that weren't
+// given, while flags on the command line win
+func TestApplyBackupDefaults(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	path, err := backupDefaultsPath()
+	if err != nil {
+		t.Fatalf("backupDefaultsPath() error = %v", err)
+	}
+	if want := filepath.Join(home, ".config", "oadp", "backup-defaults.yaml"); path != want {
+		t.Errorf("backupDefaultsPath() = %q, want %q", path, want)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatalf("MkdirAll() error = %v", err)
+	}
+	defaults := `storage-location: team-nabsl
+snapshot-move-data: true
+ttl: 72h
+include-resources: [deployments.apps, configmaps]
+labels:
+  team: payments
+  env: dev
+`
+	if err := os.WriteFile(path, []byte(defaults), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	o := NewCreateOptions()
+	c := &cobra.Command{}
+	o.BindFlags(c.Flags())
+	if err := c.Flags().Parse([]string{"--storage-location", "my-nabsl", "--labels", "team=checkout"}); err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if err := applyBackupDefaults(c.Flags(), path); err != nil {
+		t.Fatalf("applyBackupDefaults() error = %v", err)
+	}
+
+	if o.StorageLocation != "my-nabsl" {
+		t.Errorf("expected --storage-location to win over the defaults, got %q", o.StorageLocation)
+	}
+	if got := o.Labels.Data(); !reflect.DeepEqual(got, map[string]string{"team": "checkout"}) {
+		t.Errorf("expected --labels to win over the defaults, got %v", got)
+	}
+	if o.SnapshotMoveData.Value == nil || !*o.SnapshotMoveData.Value {
+		t.Errorf("expected snapshot-move-data to default to true, got %v", o.SnapshotMoveData.Value)
+	}
+	if o.TTL != 72*time.Hour {
+		t.Errorf("expected ttl to default to 72h, got %v", o.TTL)
+	}
+	if got := []string(o.IncludeResources); !reflect.DeepEqual(got, []string{"deployments.apps", "configmaps"}) {
+		t.Errorf("expected include-resources from the defaults, got %v", got)
+	}
+}
+
+// TestApplyBackupDefaultsErrors verifies a missing defaults file is ignored and unknown
+// flags are reported
+func TestApplyBackupDefaultsErrors(t *testing.T) {
+	o := NewCreateOptions()
+	c := &cobra.Command{}
+	o.BindFlags(c.Flags())
+
+	dir := t.TempDir()
+	if err := applyBackupDefaults(c.Flags(), filepath.Join(dir, "missing.yaml")); err != nil {
+		t.Errorf("expected a missing defaults file to be ignored, got %v", err)
+	}
+
+	path := filepath.Join(dir, "backup-defaults.yaml")
+	if err := os.WriteFile(path, []byte("storage-locaton: typo\n"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	err := applyBackupDefaults(c.Flags(), path)
+	if want := `unknown flag "storage-locaton"`; err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("applyBackupDefaults() error = %v, want it to contain %q", err, want)
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"time"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/migtools/oadp-cli/cmd/shared"
+)
+
+// usesDataMover reports whether the backup moves its snapshots with the data mover
+// and has a Velero backup whose DataUploads can be looked up
+func usesDataMover(nab *nacv1alpha1.NonAdminBackup) bool {
+	if nab.Spec.BackupSpec == nil || nab.Spec.BackupSpec.SnapshotMoveData == nil || !*nab.Spec.BackupSpec.SnapshotMoveData {
+		return false
+	}
+	return nab.Status.VeleroBackup != nil && nab.Status.VeleroBackup.Name != ""
+}
+
+// averageUploadSpeed returns the data mover upload speed of the backup. DataUploads live in
+// the OADP namespace, which non-admin users usually cannot read, so ok is false whenever the
+// speed is unknown.
+func averageUploadSpeed(ctx context.Context, kbClient kbclient.Client, nab *nacv1alpha1.NonAdminBackup) (speed string, ok bool) {
+	if !usesDataMover(nab) {
+		return "", false
+	}
+
+	var uploads velerov2alpha1.DataUploadList
+	if err := kbClient.List(ctx, &uploads,
+		kbclient.InNamespace(nab.Status.VeleroBackup.Namespace),
+		kbclient.MatchingLabels{velerov1.BackupNameLabel: nab.Status.VeleroBackup.Name},
+	); err != nil {
+		return "", false
+	}
+
+	return shared.UploadSpeed(uploads.Items, time.Now())
+}
+
+// transferIndex returns the upload speed and nodes of each data mover backup, keyed by
+// NonAdminBackup name. DataUploads are listed once per OADP namespace rather than once
+// per backup; backups whose uploads cannot be read are left out.
+func transferIndex(ctx context.Context, kbClient kbclient.Client, items []nacv1alpha1.NonAdminBackup) map[string]shared.DataTransfer {
+	// Velero backup name -> transfer, per OADP namespace; nil when the namespace is unreadable
+	transfersByNamespace := make(map[string]map[string]shared.DataTransfer)
+	transfers := make(map[string]shared.DataTransfer)
+	now := time.Now()
+
+	for i := range items {
+		nab := &items[i]
+		if !usesDataMover(nab) {
+			continue
+		}
+
+		namespace := nab.Status.VeleroBackup.Namespace
+		veleroTransfers, listed := transfersByNamespace[namespace]
+		if !listed {
+			veleroTransfers, _ = shared.DataTransfers(ctx, kbClient, namespace, now)
+			transfersByNamespace[namespace] = veleroTransfers
+		}
+
+		if transfer, ok := veleroTransfers[nab.Status.VeleroBackup.Name]; ok {
+			transfers[nab.Name] = transfer
+		}
+	}
+
+	return transfers
+}
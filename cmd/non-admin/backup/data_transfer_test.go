@@ -0,0 +1,99 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	"github.com/vmware-tanzu/velero/pkg/apis/velero/shared"
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+// newDataUpload returns a completed DataUpload for the Velero backup that moved bytes
+// between start and start+took
+func newDataUpload(name, veleroBackup string, bytes int64, start time.Time, took time.Duration) *velerov2alpha1.DataUpload {
+	return &velerov2alpha1.DataUpload{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "openshift-adp",
+			Labels:    map[string]string{velerov1.BackupNameLabel: veleroBackup},
+		},
+		Status: velerov2alpha1.DataUploadStatus{
+			Phase:               velerov2alpha1.DataUploadPhaseCompleted,
+			StartTimestamp:      &metav1.Time{Time: start},
+			CompletionTimestamp: &metav1.Time{Time: start.Add(took)},
+			Progress:            shared.DataMoveOperationProgress{TotalBytes: bytes, BytesDone: bytes},
+		},
+	}
+}
+
+// TestAverageUploadSpeed verifies the upload speed is aggregated across the backup's
+// DataUploads, and omitted when the backup did not use the data mover or uploads are unreadable
+func TestAverageUploadSpeed(t *testing.T) {
+	start := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	moveData := true
+	nab := &nacv1alpha1.NonAdminBackup{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-backup", Namespace: "my-project"},
+		Spec: nacv1alpha1.NonAdminBackupSpec{
+			BackupSpec: &velerov1.BackupSpec{SnapshotMoveData: &moveData},
+		},
+		Status: nacv1alpha1.NonAdminBackupStatus{
+			VeleroBackup: &nacv1alpha1.VeleroBackup{Name: "nab-my-backup", Namespace: "openshift-adp"},
+		},
+	}
+	uploads := []kbclient.Object{
+		// 2 GiB moved in the 100s between the first start and the last completion
+		newDataUpload("upload-1", "nab-my-backup", 1<<30, start, 60*time.Second),
+		newDataUpload("upload-2", "nab-my-backup", 1<<30, start.Add(10*time.Second), 90*time.Second),
+		newDataUpload("upload-other", "nab-other-backup", 1<<40, start, time.Second),
+	}
+
+	t.Run("aggregated across uploads", func(t *testing.T) {
+		speed, ok := averageUploadSpeed(context.Background(), newFakeClient(t, uploads...), nab)
+		if !ok || speed != "20.5 MiB/s" {
+			t.Errorf("averageUploadSpeed() = %q, %t, want %q, true", speed, ok, "20.5 MiB/s")
+		}
+	})
+
+	t.Run("no data mover", func(t *testing.T) {
+		plain := nab.DeepCopy()
+		plain.Spec.BackupSpec.SnapshotMoveData = nil
+		if speed, ok := averageUploadSpeed(context.Background(), newFakeClient(t, uploads...), plain); ok {
+			t.Errorf("averageUploadSpeed() = %q, want it omitted", speed)
+		}
+	})
+
+	t.Run("no permission", func(t *testing.T) {
+		client := interceptor.NewClient(newFakeClient(t, uploads...), interceptor.Funcs{
+			List: func(ctx context.Context, c kbclient.WithWatch, list kbclient.ObjectList, opts ...kbclient.ListOption) error {
+				return apierrors.NewForbidden(schema.GroupResource{Group: "velero.io", Resource: "datauploads"}, "", nil)
+			},
+		})
+		if speed, ok := averageUploadSpeed(context.Background(), client, nab); ok {
+			t.Errorf("averageUploadSpeed() = %q, want it omitted", speed)
+		}
+	})
+}
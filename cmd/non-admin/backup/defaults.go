@@ -0,0 +1,105 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// backupDefaultsPath returns the file holding a team's defaults for backup create,
+// ~/.config/oadp/backup-defaults.yaml
+func backupDefaultsPath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get user home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".config", "oadp", "backup-defaults.yaml"), nil
+}
+
+// applyBackupDefaults sets the flags named in the defaults file that weren't given on the
+// command line, as if they had been. Keys are flag names, e.g.
+//
+//	storage-location: my-nabsl
+//	snapshot-move-data: true
+//	include-resources: [deployments.apps, configmaps]
+//	labels: {team: payments}
+//
+// A missing file is not an error.
+func applyBackupDefaults(flags *pflag.FlagSet, path string) error {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read backup defaults: %w", err)
+	}
+
+	var defaults map[string]any
+	if err := yaml.Unmarshal(data, &defaults); err != nil {
+		return fmt.Errorf("failed to parse backup defaults %s: %w", path, err)
+	}
+
+	// Apply in a stable order so errors are reproducible
+	keys := make([]string, 0, len(defaults))
+	for key := range defaults {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		f := flags.Lookup(key)
+		if f == nil {
+			return fmt.Errorf("backup defaults %s: unknown flag %q", path, key)
+		}
+		if f.Changed {
+			continue
+		}
+		if err := flags.Set(key, defaultFlagValue(defaults[key])); err != nil {
+			return fmt.Errorf("backup defaults %s: invalid value for %q: %w", path, key, err)
+		}
+	}
+	return nil
+}
+
+// defaultFlagValue formats a defaults file value the way it would be passed on the command
+// line: lists as a, b, c and maps as k=v pairs, both comma-separated
+func defaultFlagValue(value any) string {
+	switch v := value.(type) {
+	case []any:
+		items := make([]string, len(v))
+		for i, item := range v {
+			items[i] = fmt.Sprint(item)
+		}
+		return strings.Join(items, ",")
+	case map[string]any:
+		pairs := make([]string, 0, len(v))
+		for key, item := range v {
+			pairs = append(pairs, fmt.Sprintf("%s=%v", key, item))
+		}
+		sort.Strings(pairs)
+		return strings.Join(pairs, ",")
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -17,11 +17,13 @@ limitations under the License.
 */
 
 import (
-	"bufio"
 	"context"
+	"encoding/json"
 	"fmt"
-	"os"
+	"io"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -34,6 +36,13 @@ import (
 
 	"github.com/migtools/oadp-cli/cmd/shared"
 	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+const (
+	defaultDeleteWaitTimeout  = 10 * time.Minute
+	defaultDeletePollInterval = 2 * time.Second
+	defaultConfirmThreshold   = 10
 )
 
 // NewDeleteCommand creates a cobra command for deleting non-admin backups
@@ -48,7 +57,7 @@ func NewDeleteCommand(f client.Factory, use string) *cobra.Command {
 		Run: func(c *cobra.Command, args []string) {
 			cmd.CheckError(o.Complete(args, f))
 			cmd.CheckError(o.Validate())
-			cmd.CheckError(o.Run())
+			cmd.CheckError(o.Run(c))
 		},
 	}
 
@@ -61,20 +70,37 @@ func NewDeleteCommand(f client.Factory, use string) *cobra.Command {
 
 // DeleteOptions holds the options for the delete command
 type DeleteOptions struct {
-	Names     []string
-	Namespace string // Internal field - automatically determined from kubectl context
-	Confirm   bool   // Skip confirmation prompt
-	client    kbclient.Client
+	Names            []string
+	Namespace        string // Internal field - automatically determined from kubectl context
+	Confirm          bool   // Skip confirmation prompt
+	ConfirmThreshold int    // Above this many backups, the count must be typed to confirm
+	Parallelism      int    // Maximum number of backups processed concurrently
+	Wait             bool   // Block until the backups are actually removed
+	Timeout          time.Duration
+	DryRun           bool // Only print what would be deleted
+	client           kbclient.Client
+
+	pollInterval time.Duration
 }
 
 // NewDeleteOptions creates a new DeleteOptions instance
 func NewDeleteOptions() *DeleteOptions {
-	return &DeleteOptions{}
+	return &DeleteOptions{
+		Parallelism:      shared.DefaultParallelism,
+		ConfirmThreshold: defaultConfirmThreshold,
+		Timeout:          defaultDeleteWaitTimeout,
+		pollInterval:     defaultDeletePollInterval,
+	}
 }
 
 // BindFlags binds the command line flags to the options
 func (o *DeleteOptions) BindFlags(flags *pflag.FlagSet) {
-	flags.BoolVar(&o.Confirm, "confirm", false, "Skip confirmation prompt and delete immediately")
+	shared.BindConfirmFlags(flags, &o.Confirm)
+	flags.IntVar(&o.Parallelism, "parallelism", o.Parallelism, "Maximum number of backups to process concurrently")
+	flags.IntVar(&o.ConfirmThreshold, "confirm-threshold", o.ConfirmThreshold, "Require typing the number of backups to confirm when deleting more than this many")
+	flags.BoolVar(&o.DryRun, "dry-run", false, "Print the backups that would be marked for deletion without changing anything")
+	flags.BoolVar(&o.Wait, "wait", false, "Wait until the backups have been removed")
+	flags.DurationVar(&o.Timeout, "timeout", o.Timeout, "How long to wait for the backups to be removed when using --wait")
 }
 
 // Complete completes the options by setting up the client and determining the namespace
@@ -109,153 +135,295 @@ func (o *DeleteOptions) Validate() error {
 	if o.Namespace == "" {
 		return fmt.Errorf("namespace is required")
 	}
+	if o.Parallelism < 1 {
+		return fmt.Errorf("--parallelism must be at least 1")
+	}
+	if o.ConfirmThreshold < 0 {
+		return fmt.Errorf("--confirm-threshold must not be negative")
+	}
+	if o.Wait && o.Timeout <= 0 {
+		return fmt.Errorf("--timeout must be greater than 0")
+	}
 	return nil
 }
 
+// deleteResult is the machine-readable summary printed with -o json
+type deleteResult struct {
+	Successful []string        `json:"successful"`
+	Failed     []deleteFailure `json:"failed"`
+}
+
+// deleteFailure records why a backup could not be deleted
+type deleteFailure struct {
+	Name  string `json:"name"`
+	Error string `json:"error"`
+}
+
 // Run executes the delete command
-func (o *DeleteOptions) Run() error {
+func (o *DeleteOptions) Run(c *cobra.Command) error {
+	w := c.OutOrStdout()
+
+	jsonOutput := false
+	switch format := output.GetOutputFlagValue(c); format {
+	case "":
+	case "json":
+		if !o.Confirm || o.DryRun {
+			return fmt.Errorf("-o json requires --confirm (or --assume-yes) and cannot be combined with --dry-run")
+		}
+		// The human-readable progress would corrupt the JSON document
+		jsonOutput = true
+		w = io.Discard
+	default:
+		return fmt.Errorf("unsupported output format %q, only json is supported", format)
+	}
+
+	if o.DryRun {
+		return o.printDryRun(w)
+	}
+
 	// Show what will be deleted
-	fmt.Printf("The following NonAdminBackup(s) will be marked for deletion in namespace '%s':\n", o.Namespace)
+	fmt.Fprintf(w, "The following NonAdminBackup(s) will be marked for deletion in namespace '%s':\n", o.Namespace)
 	for _, name := range o.Names {
-		fmt.Printf("  - %s\n", name)
+		fmt.Fprintf(w, "  - %s\n", name)
 	}
-	fmt.Println()
+	fmt.Fprintln(w)
 
-	// Prompt for confirmation unless --confirm flag is used
+	// Prompt for confirmation unless --confirm or --assume-yes is used
 	if !o.Confirm {
-		confirmed, err := o.promptForConfirmation()
+		confirmed, err := o.promptForConfirmation(c.InOrStdin(), w)
 		if err != nil {
 			return err
 		}
 		if !confirmed {
-			fmt.Println("Deletion cancelled.")
+			fmt.Fprintln(w, "Deletion cancelled.")
 			return nil
 		}
 	}
 
 	// Track results
+	symbols := shared.Symbols(w)
 	var successful []string
-	var failed []string
-
-	// Process each backup
-	for _, name := range o.Names {
-		err := o.deleteBackup(name)
-		if err != nil {
-			fmt.Printf("❌ Failed to mark %s for deletion: %v\n", name, err)
-			failed = append(failed, name)
+	var failed []deleteFailure
+	var notRemoved []deleteFailure
+
+	// Process the backups concurrently, then report in input order
+	errs := shared.ForEachParallel(o.Names, o.Parallelism, o.deleteBackup)
+	for i, name := range o.Names {
+		if err := errs[i]; err != nil {
+			fmt.Fprintf(w, "%s Failed to mark %s for deletion: %v\n", symbols.Fail, name, err)
+			failed = append(failed, deleteFailure{Name: name, Error: err.Error()})
 		} else {
-			fmt.Printf("✓ %s marked for deletion\n", name)
+			fmt.Fprintf(w, "%s %s marked for deletion\n", symbols.OK, name)
 			successful = append(successful, name)
 		}
 	}
 
 	// Print summary
-	fmt.Println()
+	fmt.Fprintln(w)
 	if len(successful) > 0 {
-		fmt.Printf("Successfully marked %d backup(s) for deletion:\n", len(successful))
+		fmt.Fprintf(w, "Successfully marked %d backup(s) for deletion:\n", len(successful))
 		for _, name := range successful {
-			fmt.Printf("  - %s\n", name)
+			fmt.Fprintf(w, "  - %s\n", name)
+		}
+		fmt.Fprintln(w)
+		if o.Wait {
+			notRemoved = o.waitForDeletion(w, successful)
+		} else {
+			fmt.Fprintf(w, "%sNote: The actual backup deletion will be performed asynchronously by the OADP controller.\n", symbols.Info)
+			fmt.Fprintln(w, "   This may take some time to complete. You can monitor progress with:")
+			fmt.Fprintf(w, "   kubectl get nonadminbackup -n %s\n", o.Namespace)
 		}
-		fmt.Println()
-		fmt.Println("ℹ️  Note: The actual backup deletion will be performed asynchronously by the OADP controller.")
-		fmt.Println("   This may take some time to complete. You can monitor progress with:")
-		fmt.Printf("   kubectl get nonadminbackup -n %s\n", o.Namespace)
 	}
 
+	if jsonOutput {
+		return printDeleteResult(c.OutOrStdout(), successful, append(failed, notRemoved...))
+	}
+
+	// Report both lists before failing, so --wait results aren't lost when marking
+	// some of the other backups failed
 	if len(failed) > 0 {
-		fmt.Printf("Failed to mark %d backup(s) for deletion:\n", len(failed))
-		for _, name := range failed {
-			fmt.Printf("  - %s\n", name)
+		fmt.Fprintf(w, "Failed to mark %d backup(s) for deletion:\n", len(failed))
+		for _, failure := range failed {
+			fmt.Fprintf(w, "  - %s\n", failure.Name)
+		}
+	}
+
+	if len(notRemoved) > 0 {
+		fmt.Fprintf(w, "\n%d backup(s) were not removed:\n", len(notRemoved))
+		for _, failure := range notRemoved {
+			fmt.Fprintf(w, "  - %s\n", failure.Name)
 		}
+	}
+
+	switch {
+	case len(failed) > 0 && len(notRemoved) > 0:
+		return fmt.Errorf("some operations failed and some backups were not removed")
+	case len(failed) > 0:
 		return fmt.Errorf("some operations failed")
+	case len(notRemoved) > 0:
+		return fmt.Errorf("some backups were not removed")
 	}
 
 	return nil
 }
 
-// promptForConfirmation prompts the user for confirmation
-func (o *DeleteOptions) promptForConfirmation() (bool, error) {
-	reader := bufio.NewReader(os.Stdin)
+// printDeleteResult prints the -o json summary. Backups that failed after being marked
+// (e.g. with --wait) are moved from successful to failed. It returns an error if
+// anything failed, so scripts can rely on the exit code as well.
+func printDeleteResult(w io.Writer, successful []string, failed []deleteFailure) error {
+	failedNames := make(map[string]bool, len(failed))
+	for _, failure := range failed {
+		failedNames[failure.Name] = true
+	}
 
-	if len(o.Names) == 1 {
-		fmt.Printf("Are you sure you want to delete backup '%s'? (y/N): ", o.Names[0])
-	} else {
-		fmt.Printf("Are you sure you want to delete these %d backups? (y/N): ", len(o.Names))
+	result := deleteResult{Successful: []string{}, Failed: []deleteFailure{}}
+	for _, name := range successful {
+		if !failedNames[name] {
+			result.Successful = append(result.Successful, name)
+		}
 	}
+	result.Failed = append(result.Failed, failed...)
 
-	response, err := reader.ReadString('\n')
-	if err != nil {
-		return false, fmt.Errorf("failed to read user input: %w", err)
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(result); err != nil {
+		return fmt.Errorf("failed to encode delete result: %w", err)
 	}
 
-	response = strings.TrimSpace(strings.ToLower(response))
-	return response == "y" || response == "yes", nil
+	if len(result.Failed) > 0 {
+		return fmt.Errorf("some operations failed")
+	}
+	return nil
+}
+
+// printDryRun lists the backups that would be marked for deletion, noting any that
+// don't exist, without prompting or changing anything
+func (o *DeleteOptions) printDryRun(w io.Writer) error {
+	ctx, cancel := shared.RequestContext()
+	defer cancel()
+
+	fmt.Fprintf(w, "Dry run: the following NonAdminBackup(s) would be marked for deletion in namespace '%s':\n", o.Namespace)
+	for _, name := range o.Names {
+		nab := &nacv1alpha1.NonAdminBackup{}
+		err := o.client.Get(ctx, kbclient.ObjectKey{Name: name, Namespace: o.Namespace}, nab)
+		switch {
+		case err == nil:
+			fmt.Fprintf(w, "  - %s\n", name)
+		case errors.IsNotFound(err):
+			fmt.Fprintf(w, "  - %s (not found)\n", name)
+		default:
+			return shared.TranslateError("backup", name, err)
+		}
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "No changes were made.")
+	return nil
+}
+
+// promptForConfirmation prompts the user for confirmation. Deleting more backups than
+// the confirm threshold requires typing their exact count instead of y/N.
+func (o *DeleteOptions) promptForConfirmation(in io.Reader, w io.Writer) (bool, error) {
+	count := len(o.Names)
+	if count <= o.ConfirmThreshold {
+		question := fmt.Sprintf("Are you sure you want to delete these %d backups?", count)
+		if count == 1 {
+			question = fmt.Sprintf("Are you sure you want to delete backup '%s'?", o.Names[0])
+		}
+		return shared.Confirm(in, w, question)
+	}
+
+	prompt := fmt.Sprintf("You are about to delete %d backups. Type %d to confirm", count, count)
+	return shared.ConfirmTyped(in, w, prompt, strconv.Itoa(count))
 }
 
 // deleteBackup deletes a single backup
 func (o *DeleteOptions) deleteBackup(name string) error {
+	ctx, cancel := shared.RequestContext()
+	defer cancel()
+
 	// Get the NonAdminBackup resource
 	nab := &nacv1alpha1.NonAdminBackup{}
-	err := o.client.Get(context.TODO(), kbclient.ObjectKey{
+	err := o.client.Get(ctx, kbclient.ObjectKey{
 		Name:      name,
 		Namespace: o.Namespace,
 	}, nab)
 	if err != nil {
-		return o.translateError(name, err)
+		return shared.TranslateError("backup", name, err)
 	}
 
 	// Set the deletebackup field to true
 	nab.Spec.DeleteBackup = true
 
 	// Update the resource
-	err = o.client.Update(context.TODO(), nab)
+	err = o.client.Update(ctx, nab)
 	if err != nil {
-		return o.translateError(name, err)
+		return shared.TranslateError("backup", name, err)
 	}
 
 	return nil
 }
 
-// translateError converts verbose Kubernetes errors into user-friendly messages
-func (o *DeleteOptions) translateError(name string, err error) error {
-	if errors.IsNotFound(err) {
-		return fmt.Errorf("backup '%s' not found", name)
-	}
-
-	if errors.IsForbidden(err) {
-		return fmt.Errorf("permission denied")
-	}
-
-	if errors.IsUnauthorized(err) {
-		return fmt.Errorf("authentication required")
-	}
-
-	if errors.IsConflict(err) {
-		return fmt.Errorf("backup '%s' was modified, please try again", name)
-	}
-
-	if errors.IsTimeout(err) {
-		return fmt.Errorf("request timed out")
-	}
-
-	if errors.IsServerTimeout(err) {
-		return fmt.Errorf("server timeout")
-	}
+// waitForDeletion polls the given backups until each one is gone, reports a delete
+// failure or the timeout expires, printing the final status of every backup. It
+// returns the backups that were not removed.
+func (o *DeleteOptions) waitForDeletion(w io.Writer, names []string) []deleteFailure {
+	ctx, cancel := context.WithTimeout(context.Background(), o.Timeout)
+	defer cancel()
+
+	fmt.Fprintf(w, "Waiting for %d backup(s) to be removed...\n", len(names))
+
+	symbols := shared.Symbols(w)
+	pending := append([]string(nil), names...)
+	var failed []deleteFailure
+
+	ticker := time.NewTicker(o.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		var remaining []string
+		for _, name := range pending {
+			nab := &nacv1alpha1.NonAdminBackup{}
+			err := o.client.Get(ctx, kbclient.ObjectKey{Name: name, Namespace: o.Namespace}, nab)
+			switch {
+			case errors.IsNotFound(err):
+				fmt.Fprintf(w, "%s %s deleted\n", symbols.OK, name)
+			case err != nil && ctx.Err() == nil:
+				err = shared.TranslateError("backup", name, err)
+				fmt.Fprintf(w, "%s Failed to check %s: %v\n", symbols.Fail, name, err)
+				failed = append(failed, deleteFailure{Name: name, Error: err.Error()})
+			case err == nil && deleteFailed(nab):
+				reason := strings.Join(nab.Status.VeleroDeleteBackupRequest.Status.Errors, "; ")
+				fmt.Fprintf(w, "%s Deletion of %s failed: %s\n", symbols.Fail, name, reason)
+				failed = append(failed, deleteFailure{Name: name, Error: reason})
+			default:
+				remaining = append(remaining, name)
+			}
+		}
 
-	if errors.IsServiceUnavailable(err) {
-		return fmt.Errorf("service unavailable")
-	}
+		pending = remaining
+		if len(pending) == 0 {
+			return failed
+		}
 
-	// Check for common connection issues
-	errStr := err.Error()
-	if strings.Contains(errStr, "connection refused") {
-		return fmt.Errorf("cannot connect to cluster")
+		select {
+		case <-ctx.Done():
+			for _, name := range pending {
+				reason := fmt.Sprintf("timed out after %s waiting for deletion", o.Timeout)
+				fmt.Fprintf(w, "%s Timed out after %s waiting for %s to be deleted\n", symbols.Fail, o.Timeout, name)
+				failed = append(failed, deleteFailure{Name: name, Error: reason})
+			}
+			return failed
+		case <-ticker.C:
+			fmt.Fprintf(w, "  %d backup(s) still being deleted...\n", len(pending))
+		}
 	}
+}
 
-	if strings.Contains(errStr, "no such host") {
-		return fmt.Errorf("cannot reach cluster")
+// deleteFailed reports whether the Velero delete request of a NonAdminBackup finished with errors
+func deleteFailed(nab *nacv1alpha1.NonAdminBackup) bool {
+	request := nab.Status.VeleroDeleteBackupRequest
+	if request == nil || request.Status == nil {
+		return false
 	}
-
-	// For any other error, provide a generic message
-	return fmt.Errorf("operation failed")
+	return request.Status.Phase == velerov1.DeleteBackupRequestPhaseProcessed && len(request.Status.Errors) > 0
 }
@@ -0,0 +1,540 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
+
+	"github.com/migtools/oadp-cli/cmd/shared"
+)
+
+// TestDeleteParallel verifies that backups are deleted concurrently within the
+// parallelism bound and reported in input order
+func TestDeleteParallel(t *testing.T) {
+	var names []string
+	var objs []kbclient.Object
+	for i := 0; i < 10; i++ {
+		name := fmt.Sprintf("backup-%02d", i)
+		names = append(names, name)
+		objs = append(objs, &nacv1alpha1.NonAdminBackup{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "my-project"},
+		})
+	}
+	// Reverse the order so completion order can't accidentally match input order
+	for i, j := 0, len(names)-1; i < j; i, j = i+1, j-1 {
+		names[i], names[j] = names[j], names[i]
+	}
+
+	var mu sync.Mutex
+	active, maxActive := 0, 0
+	fakeClient := newFakeClient(t, objs...)
+	client := interceptor.NewClient(fakeClient, interceptor.Funcs{
+		Update: func(ctx context.Context, c kbclient.WithWatch, obj kbclient.Object, opts ...kbclient.UpdateOption) error {
+			mu.Lock()
+			active++
+			if active > maxActive {
+				maxActive = active
+			}
+			mu.Unlock()
+
+			time.Sleep(10 * time.Millisecond)
+
+			mu.Lock()
+			active--
+			mu.Unlock()
+			return c.Update(ctx, obj, opts...)
+		},
+	})
+
+	o := &DeleteOptions{
+		Names:       names,
+		Namespace:   "my-project",
+		Confirm:     true,
+		Parallelism: 4,
+		client:      client,
+	}
+	var out bytes.Buffer
+	c := &cobra.Command{}
+	c.SetOut(&out)
+	if err := o.Run(c); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	if maxActive > o.Parallelism {
+		t.Errorf("expected at most %d concurrent deletes, got %d", o.Parallelism, maxActive)
+	}
+
+	for _, name := range names {
+		var nab nacv1alpha1.NonAdminBackup
+		if err := fakeClient.Get(context.Background(), kbclient.ObjectKey{Namespace: "my-project", Name: name}, &nab); err != nil {
+			t.Fatalf("failed to get %s: %v", name, err)
+		}
+		if !nab.Spec.DeleteBackup {
+			t.Errorf("expected %s to be marked for deletion", name)
+		}
+	}
+
+	summary := out.String()[strings.Index(out.String(), "Successfully marked"):]
+	last := -1
+	for _, name := range names {
+		idx := strings.Index(summary, "  - "+name)
+		if idx < last {
+			t.Errorf("expected %s to be listed in input order, summary:\n%s", name, summary)
+		}
+		last = idx
+	}
+}
+
+// TestDeleteParallelAggregatesErrors verifies one failing backup doesn't stop the others
+func TestDeleteParallelAggregatesErrors(t *testing.T) {
+	nab := &nacv1alpha1.NonAdminBackup{
+		ObjectMeta: metav1.ObjectMeta{Name: "exists", Namespace: "my-project"},
+	}
+
+	o := &DeleteOptions{
+		Names:       []string{"missing", "exists"},
+		Namespace:   "my-project",
+		Confirm:     true,
+		Parallelism: 4,
+		client:      newFakeClient(t, nab),
+	}
+	var out bytes.Buffer
+	c := &cobra.Command{}
+	c.SetOut(&out)
+	if err := o.Run(c); err == nil {
+		t.Fatal("expected an error when a backup is missing")
+	}
+
+	for _, want := range []string{"[FAIL] Failed to mark missing for deletion: backup 'missing' not found", "[OK] exists marked for deletion"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, out.String())
+		}
+	}
+}
+
+// TestDeleteRequestTimeout verifies that an API call outliving --request-timeout is
+// reported as a timeout rather than hanging
+func TestDeleteRequestTimeout(t *testing.T) {
+	shared.RequestTimeout = 10 * time.Millisecond
+	t.Cleanup(func() { shared.RequestTimeout = shared.DefaultRequestTimeout })
+
+	nab := &nacv1alpha1.NonAdminBackup{
+		ObjectMeta: metav1.ObjectMeta{Name: "slow", Namespace: "my-project"},
+	}
+	client := interceptor.NewClient(newFakeClient(t, nab), interceptor.Funcs{
+		Get: func(ctx context.Context, c kbclient.WithWatch, key kbclient.ObjectKey, obj kbclient.Object, opts ...kbclient.GetOption) error {
+			// Simulate an unresponsive API server
+			<-ctx.Done()
+			return ctx.Err()
+		},
+	})
+
+	o := &DeleteOptions{
+		Names:       []string{"slow"},
+		Namespace:   "my-project",
+		Confirm:     true,
+		Parallelism: 1,
+		client:      client,
+	}
+	var out bytes.Buffer
+	c := &cobra.Command{}
+	c.SetOut(&out)
+	if err := o.Run(c); err == nil {
+		t.Fatal("expected an error when the request times out")
+	}
+
+	if want := "[FAIL] Failed to mark slow for deletion: request timed out"; !strings.Contains(out.String(), want) {
+		t.Errorf("expected output to contain %q, got:\n%s", want, out.String())
+	}
+}
+
+// TestDeleteWait verifies that --wait blocks until the NonAdminBackup is gone, and
+// reports a failed Velero delete request or a timeout as an error
+func TestDeleteWait(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      *velerov1.DeleteBackupRequestStatus
+		removeAfter int
+		timeout     time.Duration
+		expectErr   bool
+		expectOut   string
+	}{
+		{
+			name:        "removed after a few polls",
+			removeAfter: 2,
+			timeout:     time.Minute,
+			expectOut:   "[OK] my-backup deleted",
+		},
+		{
+			name: "delete request failed",
+			status: &velerov1.DeleteBackupRequestStatus{
+				Phase:  velerov1.DeleteBackupRequestPhaseProcessed,
+				Errors: []string{"error deleting backup from object storage"},
+			},
+			timeout:   time.Minute,
+			expectErr: true,
+			expectOut: "[FAIL] Deletion of my-backup failed: error deleting backup from object storage",
+		},
+		{
+			name:      "timed out",
+			timeout:   50 * time.Millisecond,
+			expectErr: true,
+			expectOut: "[FAIL] Timed out after 50ms waiting for my-backup to be deleted",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nab := &nacv1alpha1.NonAdminBackup{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-backup", Namespace: "my-project"},
+			}
+			if tt.status != nil {
+				nab.Status.VeleroDeleteBackupRequest = &nacv1alpha1.VeleroDeleteBackupRequest{Status: tt.status}
+			}
+
+			polls := 0
+			client := interceptor.NewClient(newFakeClient(t, nab), interceptor.Funcs{
+				Get: func(ctx context.Context, c kbclient.WithWatch, key kbclient.ObjectKey, obj kbclient.Object, opts ...kbclient.GetOption) error {
+					if err := c.Get(ctx, key, obj, opts...); err != nil {
+						return err
+					}
+					backup := obj.(*nacv1alpha1.NonAdminBackup)
+					if !backup.Spec.DeleteBackup {
+						return nil
+					}
+					polls++
+					if tt.removeAfter > 0 && polls > tt.removeAfter {
+						if err := c.Delete(ctx, backup); err != nil {
+							return err
+						}
+						return c.Get(ctx, key, obj, opts...)
+					}
+					return nil
+				},
+			})
+
+			o := NewDeleteOptions()
+			o.Names = []string{"my-backup"}
+			o.Namespace = "my-project"
+			o.Confirm = true
+			o.Wait = true
+			o.Timeout = tt.timeout
+			o.pollInterval = 5 * time.Millisecond
+			o.client = client
+
+			var out bytes.Buffer
+			c := &cobra.Command{}
+			c.SetOut(&out)
+			err := o.Run(c)
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("Run() error = %v, expectErr %v\n%s", err, tt.expectErr, out.String())
+			}
+			if !strings.Contains(out.String(), tt.expectOut) {
+				t.Errorf("expected output to contain %q, got:\n%s", tt.expectOut, out.String())
+			}
+			if tt.removeAfter > 0 && polls <= tt.removeAfter {
+				t.Errorf("expected more than %d polls, got %d", tt.removeAfter, polls)
+			}
+		})
+	}
+}
+
+// TestDeleteWaitReportsMarkFailures verifies the --wait results are still reported when
+// some of the other backups could not be marked for deletion
+func TestDeleteWaitReportsMarkFailures(t *testing.T) {
+	nab := &nacv1alpha1.NonAdminBackup{
+		ObjectMeta: metav1.ObjectMeta{Name: "exists", Namespace: "my-project"},
+	}
+
+	o := NewDeleteOptions()
+	o.Names = []string{"missing", "exists"}
+	o.Namespace = "my-project"
+	o.Confirm = true
+	o.Wait = true
+	o.Timeout = 20 * time.Millisecond
+	o.pollInterval = 5 * time.Millisecond
+	o.client = newFakeClient(t, nab)
+
+	var out bytes.Buffer
+	c := &cobra.Command{}
+	c.SetOut(&out)
+	err := o.Run(c)
+	if want := "some operations failed and some backups were not removed"; err == nil || err.Error() != want {
+		t.Fatalf("Run() error = %v, want %q", err, want)
+	}
+
+	for _, want := range []string{"Failed to mark 1 backup(s) for deletion:\n  - missing", "1 backup(s) were not removed:\n  - exists"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, out.String())
+		}
+	}
+}
+
+// TestDeleteDryRun verifies --dry-run lists the backups without prompting or changing them
+func TestDeleteDryRun(t *testing.T) {
+	nab := &nacv1alpha1.NonAdminBackup{
+		ObjectMeta: metav1.ObjectMeta{Name: "exists", Namespace: "my-project"},
+	}
+
+	writes := 0
+	client := interceptor.NewClient(newFakeClient(t, nab), interceptor.Funcs{
+		Update: func(ctx context.Context, c kbclient.WithWatch, obj kbclient.Object, opts ...kbclient.UpdateOption) error {
+			writes++
+			return c.Update(ctx, obj, opts...)
+		},
+		Delete: func(ctx context.Context, c kbclient.WithWatch, obj kbclient.Object, opts ...kbclient.DeleteOption) error {
+			writes++
+			return c.Delete(ctx, obj, opts...)
+		},
+	})
+
+	// Confirm is left unset: a dry run must not prompt
+	o := NewDeleteOptions()
+	o.Names = []string{"exists", "missing"}
+	o.Namespace = "my-project"
+	o.DryRun = true
+	o.client = client
+
+	var out bytes.Buffer
+	c := &cobra.Command{}
+	c.SetOut(&out)
+	if err := o.Run(c); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	if writes != 0 {
+		t.Errorf("expected no Update/Delete calls in a dry run, got %d", writes)
+	}
+	for _, want := range []string{"would be marked for deletion", "  - exists\n", "  - missing (not found)", "No changes were made."} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, out.String())
+		}
+	}
+}
+
+// TestDeleteConfirmThreshold verifies that deleting more backups than the threshold
+// requires typing their exact count
+func TestDeleteConfirmThreshold(t *testing.T) {
+	tests := []struct {
+		name         string
+		count        int
+		input        string
+		expectPrompt string
+		expectMarked bool
+	}{
+		{
+			name:         "correct count",
+			count:        12,
+			input:        "12\n",
+			expectPrompt: "Type 12 to confirm",
+			expectMarked: true,
+		},
+		{
+			name:         "correct count without a trailing newline",
+			count:        12,
+			input:        "12",
+			expectPrompt: "Type 12 to confirm",
+			expectMarked: true,
+		},
+		{
+			name:         "input closed",
+			count:        12,
+			input:        "",
+			expectPrompt: "Type 12 to confirm",
+		},
+		{
+			name:         "wrong count",
+			count:        12,
+			input:        "11\n",
+			expectPrompt: "Type 12 to confirm",
+		},
+		{
+			name:         "y is not enough above the threshold",
+			count:        12,
+			input:        "y\n",
+			expectPrompt: "Type 12 to confirm",
+		},
+		{
+			name:         "y/N at the threshold",
+			count:        10,
+			input:        "y\n",
+			expectPrompt: "(y/N)",
+			expectMarked: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var names []string
+			var objs []kbclient.Object
+			for i := 0; i < tt.count; i++ {
+				name := fmt.Sprintf("backup-%02d", i)
+				names = append(names, name)
+				objs = append(objs, &nacv1alpha1.NonAdminBackup{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "my-project"},
+				})
+			}
+			fakeClient := newFakeClient(t, objs...)
+
+			o := NewDeleteOptions()
+			o.Names = names
+			o.Namespace = "my-project"
+			o.client = fakeClient
+
+			var out bytes.Buffer
+			c := &cobra.Command{}
+			c.SetOut(&out)
+			c.SetIn(strings.NewReader(tt.input))
+			if err := o.Run(c); err != nil {
+				t.Fatalf("Run() error = %v", err)
+			}
+
+			if !strings.Contains(out.String(), tt.expectPrompt) {
+				t.Errorf("expected prompt to contain %q, got:\n%s", tt.expectPrompt, out.String())
+			}
+			if !tt.expectMarked && !strings.Contains(out.String(), "Deletion cancelled.") {
+				t.Errorf("expected deletion to be cancelled, got:\n%s", out.String())
+			}
+
+			for _, name := range names {
+				var nab nacv1alpha1.NonAdminBackup
+				if err := fakeClient.Get(context.Background(), kbclient.ObjectKey{Namespace: "my-project", Name: name}, &nab); err != nil {
+					t.Fatalf("failed to get %s: %v", name, err)
+				}
+				if nab.Spec.DeleteBackup != tt.expectMarked {
+					t.Errorf("expected %s marked for deletion = %v", name, tt.expectMarked)
+				}
+			}
+		})
+	}
+}
+
+// TestDeleteConfirmFlags verifies --confirm and --assume-yes/-y both skip the prompt
+func TestDeleteConfirmFlags(t *testing.T) {
+	for _, flag := range []string{"--confirm", "--assume-yes", "-y"} {
+		t.Run(flag, func(t *testing.T) {
+			fakeClient := newFakeClient(t, &nacv1alpha1.NonAdminBackup{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-backup", Namespace: "my-project"},
+			})
+
+			o := NewDeleteOptions()
+			flags := pflag.NewFlagSet("delete", pflag.ContinueOnError)
+			o.BindFlags(flags)
+			if err := flags.Parse([]string{flag}); err != nil {
+				t.Fatalf("Parse(%s) error = %v", flag, err)
+			}
+			o.Names = []string{"my-backup"}
+			o.Namespace = "my-project"
+			o.client = fakeClient
+
+			var out bytes.Buffer
+			c := &cobra.Command{}
+			c.SetOut(&out)
+			// Any read would fail, so the prompt must not be shown
+			c.SetIn(strings.NewReader(""))
+			if err := o.Run(c); err != nil {
+				t.Fatalf("Run() error = %v", err)
+			}
+
+			if strings.Contains(out.String(), "Are you sure") {
+				t.Errorf("expected %s to skip the prompt, got:\n%s", flag, out.String())
+			}
+			var nab nacv1alpha1.NonAdminBackup
+			if err := fakeClient.Get(context.Background(), kbclient.ObjectKey{Namespace: "my-project", Name: "my-backup"}, &nab); err != nil {
+				t.Fatalf("failed to get my-backup: %v", err)
+			}
+			if !nab.Spec.DeleteBackup {
+				t.Errorf("expected my-backup to be marked for deletion")
+			}
+		})
+	}
+}
+
+// TestDeleteJSONOutput verifies -o json prints a parseable summary instead of the human one
+func TestDeleteJSONOutput(t *testing.T) {
+	nab := &nacv1alpha1.NonAdminBackup{
+		ObjectMeta: metav1.ObjectMeta{Name: "exists", Namespace: "my-project"},
+	}
+
+	o := NewDeleteOptions()
+	o.Names = []string{"exists", "missing"}
+	o.Namespace = "my-project"
+	o.Confirm = true
+	o.client = newFakeClient(t, nab)
+
+	c := &cobra.Command{}
+	output.BindFlags(c.Flags())
+	output.ClearOutputFlagDefault(c)
+	if err := c.Flags().Set("output", "json"); err != nil {
+		t.Fatalf("Failed to set output flag: %v", err)
+	}
+	var out bytes.Buffer
+	c.SetOut(&out)
+
+	if err := o.Run(c); err == nil {
+		t.Fatal("expected an error when a backup fails to delete")
+	}
+
+	var result deleteResult
+	if err := json.Unmarshal(out.Bytes(), &result); err != nil {
+		t.Fatalf("expected only JSON on stdout: %v\n%s", err, out.String())
+	}
+	if !reflect.DeepEqual(result.Successful, []string{"exists"}) {
+		t.Errorf("successful = %v, want [exists]", result.Successful)
+	}
+	want := []deleteFailure{{Name: "missing", Error: "backup 'missing' not found"}}
+	if !reflect.DeepEqual(result.Failed, want) {
+		t.Errorf("failed = %+v, want %+v", result.Failed, want)
+	}
+}
+
+// TestDeleteJSONOutputRequiresConfirm verifies -o json is not combined with a prompt
+func TestDeleteJSONOutputRequiresConfirm(t *testing.T) {
+	o := NewDeleteOptions()
+	o.Names = []string{"exists"}
+	o.Namespace = "my-project"
+	o.client = newFakeClient(t)
+
+	c := &cobra.Command{}
+	output.BindFlags(c.Flags())
+	output.ClearOutputFlagDefault(c)
+	if err := c.Flags().Set("output", "json"); err != nil {
+		t.Fatalf("Failed to set output flag: %v", err)
+	}
+
+	if err := o.Run(c); err == nil || !strings.Contains(err.Error(), "--confirm") {
+		t.Fatalf("Run() error = %v, want an error mentioning --confirm", err)
+	}
+}
@@ -1,13 +1,14 @@
 package backup
 
 import (
-	"compress/gzip"
 	"context"
 	"fmt"
 	"io"
 	"net/http"
+	"os"
 	"sort"
 	"strings"
+	"text/template"
 	"time"
 
 	"github.com/migtools/oadp-cli/cmd/shared"
@@ -16,13 +17,26 @@ import (
 	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
+	"golang.org/x/term"
 	"gopkg.in/yaml.v2"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+const (
+	// defaultSummaryTimeout bounds describe when it only reads the NonAdminBackup
+	defaultSummaryTimeout = 30 * time.Second
+	// defaultDescribeTimeout bounds the detailed describe, which downloads backup artifacts
+	defaultDescribeTimeout = 120 * time.Second
+	// defaultDescribeWidth is used to wrap long values when the output is not a terminal
+	defaultDescribeWidth = 80
+)
+
 func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
+	var timeout time.Duration
+
 	c := &cobra.Command{
 		Use:   use + " NAME",
 		Short: "Describe a non-admin backup",
@@ -30,6 +44,12 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			backupName := args[0]
 
+			// Reject a broken template before talking to the cluster
+			tmpl, err := shared.GoTemplateFlag(cmd)
+			if err != nil {
+				return err
+			}
+
 			// Get the current namespace from kubectl context
 			userNamespace, err := shared.GetCurrentNamespace()
 			if err != nil {
@@ -46,142 +66,153 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 				return err
 			}
 
-			// Shows NonAdminBackup resources
-			var nabList nacv1alpha1.NonAdminBackupList
-			if err := kbClient.List(context.Background(), &nabList, &kbclient.ListOptions{
-				Namespace: userNamespace,
-			}); err != nil {
-				return fmt.Errorf("failed to list NonAdminBackup: %w", err)
-			}
+			return describeBackup(cmd.OutOrStdout(), kbClient, userNamespace, backupName, timeout, tmpl)
+		},
+		Example: `  kubectl oadp nonadmin backup describe my-backup
 
-			// Find the specific backup
-			var targetBackup *nacv1alpha1.NonAdminBackup
-			for i := range nabList.Items {
-				if nabList.Items[i].Name == backupName {
-					targetBackup = &nabList.Items[i]
-					break
-				}
-			}
+  # Print selected fields with a Go template
+  kubectl oadp nonadmin backup describe my-backup -o go-template='{{.Phase}} {{.Errors}}'
 
-			if targetBackup == nil {
-				return fmt.Errorf("NonAdminBackup %q not found in namespace %q", backupName, userNamespace)
-			}
+  # Give up sooner on an unresponsive cluster
+  kubectl oadp nonadmin backup describe my-backup --timeout 10s`,
+	}
 
-			// Print basic info
-			fmt.Printf("Name:\t%s\n", targetBackup.Name)
-			fmt.Printf("Namespace:\t%s\n", targetBackup.Namespace)
+	c.Flags().DurationVar(&timeout, "timeout", defaultSummaryTimeout, "How long to wait for the backup details to be fetched")
 
-			// Print labels if any
-			if len(targetBackup.Labels) > 0 {
-				fmt.Printf("Labels:\t")
-				var labelPairs []string
-				for k, v := range targetBackup.Labels {
-					labelPairs = append(labelPairs, fmt.Sprintf("%s=%s", k, v))
-				}
-				sort.Strings(labelPairs)
-				fmt.Printf("%s\n", strings.Join(labelPairs, ","))
-			} else {
-				fmt.Printf("Labels:\t<none>\n")
-			}
+	output.BindFlags(c.Flags())
+	output.ClearOutputFlagDefault(c)
 
-			// Print annotations if any
-			if len(targetBackup.Annotations) > 0 {
-				fmt.Printf("Annotations:\t")
-				var annotationPairs []string
-				for k, v := range targetBackup.Annotations {
-					annotationPairs = append(annotationPairs, fmt.Sprintf("%s=%s", k, v))
-				}
-				sort.Strings(annotationPairs)
-				fmt.Printf("%s\n", strings.Join(annotationPairs, ","))
-			} else {
-				fmt.Printf("Annotations:\t<none>\n")
-			}
+	return c
+}
 
-			// Print phase/status
-			fmt.Printf("Phase:\t%s\n", targetBackup.Status.Phase)
-
-			// Print conditions
-			if len(targetBackup.Status.Conditions) > 0 {
-				fmt.Printf("Conditions:\n")
-				for _, condition := range targetBackup.Status.Conditions {
-					fmt.Printf("  Type:\t%s\n", condition.Type)
-					fmt.Printf("  Status:\t%s\n", condition.Status)
-					if condition.Reason != "" {
-						fmt.Printf("  Reason:\t%s\n", condition.Reason)
-					}
-					if condition.Message != "" {
-						fmt.Printf("  Message:\t%s\n", condition.Message)
-					}
-					fmt.Printf("  Last Transition Time:\t%s\n", condition.LastTransitionTime.Format(time.RFC3339))
-					fmt.Printf("\n")
-				}
-			}
+// describeBackup prints the NonAdminBackup summary, or renders tmpl against its
+// BackupDescription when tmpl is set, bounding the lookup by timeout
+func describeBackup(w io.Writer, kbClient kbclient.Client, userNamespace, backupName string, timeout time.Duration, tmpl *template.Template) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
 
-			// Print related Velero backup info if available
-			if targetBackup.Status.VeleroBackup != nil {
-				fmt.Printf("Velero Backup:\n")
-				fmt.Printf("  Name:\t%s\n", targetBackup.Status.VeleroBackup.Name)
-				fmt.Printf("  Namespace:\t%s\n", targetBackup.Status.VeleroBackup.Namespace)
-				if targetBackup.Status.VeleroBackup.Status != nil {
-					fmt.Printf("  Status:\n")
-					// Print some key status fields
-					if targetBackup.Status.VeleroBackup.Status.Phase != "" {
-						fmt.Printf("    Phase:\t%s\n", targetBackup.Status.VeleroBackup.Status.Phase)
-					}
-					if !targetBackup.Status.VeleroBackup.Status.StartTimestamp.IsZero() {
-						fmt.Printf("    Start Time:\t%s\n", targetBackup.Status.VeleroBackup.Status.StartTimestamp.Format(time.RFC3339))
-					}
-					if !targetBackup.Status.VeleroBackup.Status.CompletionTimestamp.IsZero() {
-						fmt.Printf("    Completion Time:\t%s\n", targetBackup.Status.VeleroBackup.Status.CompletionTimestamp.Format(time.RFC3339))
-					}
-					if targetBackup.Status.VeleroBackup.Status.Expiration != nil {
-						fmt.Printf("    Expiration:\t%s\n", targetBackup.Status.VeleroBackup.Status.Expiration.Format(time.RFC3339))
-					}
-				}
+	if tmpl != nil {
+		nab, err := findNonAdminBackup(ctx, kbClient, userNamespace, backupName)
+		if err != nil {
+			return err
+		}
+		if err := tmpl.Execute(w, newBackupDescription(nab)); err != nil {
+			return fmt.Errorf("failed to execute go-template: %w", err)
+		}
+		return nil
+	}
+
+	return printBackupSummary(ctx, w, kbClient, userNamespace, backupName)
+}
+
+// findNonAdminBackup returns the named NonAdminBackup from the user's namespace
+func findNonAdminBackup(ctx context.Context, kbClient kbclient.Client, userNamespace, backupName string) (*nacv1alpha1.NonAdminBackup, error) {
+	var nabList nacv1alpha1.NonAdminBackupList
+	if err := kbClient.List(ctx, &nabList, &kbclient.ListOptions{
+		Namespace: userNamespace,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to list NonAdminBackup: %w", err)
+	}
+
+	for i := range nabList.Items {
+		if nabList.Items[i].Name == backupName {
+			return &nabList.Items[i], nil
+		}
+	}
+	return nil, fmt.Errorf("NonAdminBackup %q not found in namespace %q", backupName, userNamespace)
+}
+
+// printBackupSummary prints the NonAdminBackup itself, without downloading any artifacts
+func printBackupSummary(ctx context.Context, w io.Writer, kbClient kbclient.Client, userNamespace, backupName string) error {
+	targetBackup, err := findNonAdminBackup(ctx, kbClient, userNamespace, backupName)
+	if err != nil {
+		return err
+	}
+
+	width := terminalWidth(w)
+
+	// Print basic info
+	fields := []describeField{
+		{"Name", targetBackup.Name},
+		{"Namespace", targetBackup.Namespace},
+		{"Labels", joinPairs(targetBackup.Labels)},
+		{"Annotations", joinPairs(targetBackup.Annotations)},
+		{"Phase", string(targetBackup.Status.Phase)},
+	}
+	if location := backupStorageLocation(ctx, kbClient, targetBackup); location != "" {
+		fields = append(fields, describeField{"Storage Location", location})
+	}
+	writeFields(w, "", fields, width)
+
+	// Print conditions
+	if len(targetBackup.Status.Conditions) > 0 {
+		printConditions(w, targetBackup.Status.Conditions, width)
+	}
+
+	// Print related Velero backup info if available
+	if targetBackup.Status.VeleroBackup != nil {
+		fmt.Fprintf(w, "Velero Backup:\n")
+		writeFields(w, "  ", []describeField{
+			{"Name", targetBackup.Status.VeleroBackup.Name},
+			{"Namespace", targetBackup.Status.VeleroBackup.Namespace},
+		}, width)
+		if status := targetBackup.Status.VeleroBackup.Status; status != nil {
+			fmt.Fprintf(w, "  Status:\n")
+			// Print some key status fields
+			var fields []describeField
+			if status.Phase != "" {
+				fields = append(fields, describeField{"Phase", string(status.Phase)})
 			}
+			if !status.StartTimestamp.IsZero() {
+				fields = append(fields, describeField{"Start Time", status.StartTimestamp.Format(time.RFC3339)})
+			}
+			if !status.CompletionTimestamp.IsZero() {
+				fields = append(fields, describeField{"Completion Time", status.CompletionTimestamp.Format(time.RFC3339)})
+			}
+			fields = append(fields, durationFields(status, time.Now())...)
+			if status.Expiration != nil {
+				fields = append(fields, describeField{"Expiration", status.Expiration.Format(time.RFC3339)})
+			}
+			writeFields(w, "    ", fields, width)
+		}
+	}
 
-			// Print the spec (what was requested)
-			if targetBackup.Spec.BackupSpec != nil {
-				fmt.Printf("\nBackup Spec:\n")
-				specBytes, err := yaml.Marshal(targetBackup.Spec.BackupSpec)
-				if err != nil {
-					fmt.Printf("  Error marshaling spec: %v\n", err)
-				} else {
-					// Indent the YAML output
-					specLines := strings.Split(string(specBytes), "\n")
-					for _, line := range specLines {
-						if line != "" {
-							fmt.Printf("  %s\n", line)
-						}
-					}
+	// Print the spec (what was requested)
+	if targetBackup.Spec.BackupSpec != nil {
+		fmt.Fprintf(w, "\nBackup Spec:\n")
+		specBytes, err := yaml.Marshal(targetBackup.Spec.BackupSpec)
+		if err != nil {
+			fmt.Fprintf(w, "  Error marshaling spec: %v\n", err)
+		} else {
+			// Indent the YAML output
+			specLines := strings.Split(string(specBytes), "\n")
+			for _, line := range specLines {
+				if line != "" {
+					fmt.Fprintf(w, "  %s\n", line)
 				}
 			}
-
-			return nil
-		},
-		Example: `  kubectl oadp nonadmin backup describe my-backup`,
+		}
 	}
 
-	output.BindFlags(c.Flags())
-	output.ClearOutputFlagDefault(c)
-
-	return c
+	return nil
 }
 
 // NonAdminDescribeBackup mirrors Velero's output.DescribeBackup functionality
 // but works within non-admin RBAC boundaries using NonAdminDownloadRequest
-func NonAdminDescribeBackup(cmd *cobra.Command, kbClient kbclient.Client, nab *nacv1alpha1.NonAdminBackup, userNamespace string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), 120*time.Second)
-	defer cancel()
+// The context bounds all downloads; see defaultDescribeTimeout.
+func NonAdminDescribeBackup(ctx context.Context, w io.Writer, kbClient kbclient.Client, httpClient *http.Client, nab *nacv1alpha1.NonAdminBackup, userNamespace string) error {
+	width := terminalWidth(w)
 
 	// Print basic backup information
-	fmt.Fprintf(cmd.OutOrStdout(), "Name:         %s\n", nab.Name)
-	fmt.Fprintf(cmd.OutOrStdout(), "Namespace:    %s\n", nab.Namespace)
+	writeFields(w, "", []describeField{
+		{"Name", nab.Name},
+		{"Namespace", nab.Namespace},
+	}, width)
 
 	// Print labels
-	fmt.Fprintf(cmd.OutOrStdout(), "Labels:\n")
+	fmt.Fprintf(w, "Labels:\n")
 	if len(nab.Labels) == 0 {
-		fmt.Fprintf(cmd.OutOrStdout(), "  <none>\n")
+		fmt.Fprintf(w, "  <none>\n")
 	} else {
 		labelKeys := make([]string, 0, len(nab.Labels))
 		for k := range nab.Labels {
@@ -189,14 +220,14 @@ func NonAdminDescribeBackup(cmd *cobra.Command, kbClient kbclient.Client, nab *n
 		}
 		sort.Strings(labelKeys)
 		for _, k := range labelKeys {
-			fmt.Fprintf(cmd.OutOrStdout(), "  %s=%s\n", k, nab.Labels[k])
+			fmt.Fprintf(w, "  %s=%s\n", k, nab.Labels[k])
 		}
 	}
 
 	// Print annotations
-	fmt.Fprintf(cmd.OutOrStdout(), "Annotations:\n")
+	fmt.Fprintf(w, "Annotations:\n")
 	if len(nab.Annotations) == 0 {
-		fmt.Fprintf(cmd.OutOrStdout(), "  <none>\n")
+		fmt.Fprintf(w, "  <none>\n")
 	} else {
 		annotationKeys := make([]string, 0, len(nab.Annotations))
 		for k := range nab.Annotations {
@@ -204,74 +235,98 @@ func NonAdminDescribeBackup(cmd *cobra.Command, kbClient kbclient.Client, nab *n
 		}
 		sort.Strings(annotationKeys)
 		for _, k := range annotationKeys {
-			fmt.Fprintf(cmd.OutOrStdout(), "  %s=%s\n", k, nab.Annotations[k])
+			fmt.Fprintf(w, "  %s=%s\n", k, nab.Annotations[k])
 		}
 	}
 
 	// Print timestamps and status from NonAdminBackup
-	fmt.Fprintf(cmd.OutOrStdout(), "Creation Timestamp:  %s\n", nab.CreationTimestamp.Format(time.RFC3339))
-	fmt.Fprintf(cmd.OutOrStdout(), "Phase:               %s\n", nab.Status.Phase)
+	fields := []describeField{
+		{"Creation Timestamp", nab.CreationTimestamp.Format(time.RFC3339)},
+		{"Phase", string(nab.Status.Phase)},
+	}
+	if location := backupStorageLocation(ctx, kbClient, nab); location != "" {
+		fields = append(fields, describeField{"Storage Location", location})
+	}
+	if nab.Status.VeleroBackup != nil && nab.Status.VeleroBackup.Status != nil {
+		fields = append(fields, durationFields(nab.Status.VeleroBackup.Status, time.Now())...)
+	}
+	writeFields(w, "", fields, width)
+
+	// Conditions explain why a backup is stuck, e.g. Accepted=False when admin enforcement rejects it
+	printConditions(w, nab.Status.Conditions, width)
 
 	// If there's a referenced Velero backup, get more details
 	if nab.Status.VeleroBackup != nil && nab.Status.VeleroBackup.Name != "" {
-		veleroBackupName := nab.Status.VeleroBackup.Name
+		// Download requests target the NonAdminBackup; the controller resolves the Velero backup
+		backupName := nab.Name
 
 		// Try to get additional backup details, but don't block if they're not available
-		fmt.Fprintf(cmd.OutOrStdout(), "\nFetching additional backup details...")
+		fmt.Fprintf(w, "\nFetching additional backup details...")
 
 		// Get backup results using NonAdminDownloadRequest (most important data)
-		if results, err := downloadBackupData(ctx, kbClient, userNamespace, veleroBackupName, "BackupResults"); err == nil {
-			fmt.Fprintf(cmd.OutOrStdout(), "\nBackup Results:\n")
-			fmt.Fprintf(cmd.OutOrStdout(), "%s", indent(results, "  "))
+		if results, err := downloadBackupData(ctx, kbClient, httpClient, userNamespace, backupName, velerov1.DownloadTargetKindBackupResults); err == nil {
+			fmt.Fprintf(w, "\nBackup Results:\n")
+			fmt.Fprintf(w, "%s", indent(results, "  "))
 		}
 
 		// Get backup details using NonAdminDownloadRequest for BackupResourceList
-		if resourceList, err := downloadBackupData(ctx, kbClient, userNamespace, veleroBackupName, "BackupResourceList"); err == nil {
-			fmt.Fprintf(cmd.OutOrStdout(), "\nBackup Resource List:\n")
-			fmt.Fprintf(cmd.OutOrStdout(), "%s", indent(resourceList, "  "))
+		if resourceList, err := downloadBackupData(ctx, kbClient, httpClient, userNamespace, backupName, velerov1.DownloadTargetKindBackupResourceList); err == nil {
+			fmt.Fprintf(w, "\nBackup Resource List:\n")
+			fmt.Fprintf(w, "%s", indent(resourceList, "  "))
 		}
 
 		// Get backup volume info using NonAdminDownloadRequest
-		if volumeInfo, err := downloadBackupData(ctx, kbClient, userNamespace, veleroBackupName, "BackupVolumeInfos"); err == nil {
-			fmt.Fprintf(cmd.OutOrStdout(), "\nBackup Volume Info:\n")
-			fmt.Fprintf(cmd.OutOrStdout(), "%s", indent(volumeInfo, "  "))
+		if volumeInfo, err := downloadBackupData(ctx, kbClient, httpClient, userNamespace, backupName, velerov1.DownloadTargetKindBackupVolumeInfos); err == nil {
+			fmt.Fprintf(w, "\nBackup Volume Info:\n")
+			fmt.Fprintf(w, "%s", indent(volumeInfo, "  "))
 		}
 
 		// Get backup item operations using NonAdminDownloadRequest
-		if itemOps, err := downloadBackupData(ctx, kbClient, userNamespace, veleroBackupName, "BackupItemOperations"); err == nil {
-			fmt.Fprintf(cmd.OutOrStdout(), "\nBackup Item Operations:\n")
-			fmt.Fprintf(cmd.OutOrStdout(), "%s", indent(itemOps, "  "))
+		if itemOps, err := downloadBackupData(ctx, kbClient, httpClient, userNamespace, backupName, velerov1.DownloadTargetKindBackupItemOperations); err == nil {
+			fmt.Fprintf(w, "\nBackup Item Operations:\n")
+			fmt.Fprintf(w, "%s", indent(itemOps, "  "))
+		}
+
+		// Only shown for data mover backups, and only when the DataUploads are readable
+		if speed, ok := averageUploadSpeed(ctx, kbClient, nab); ok {
+			fmt.Fprintln(w)
+			writeFields(w, "", []describeField{{key: "Average Upload Speed", value: speed}}, width)
 		}
 
-		fmt.Fprintf(cmd.OutOrStdout(), "\nDone fetching additional details.")
+		fmt.Fprintf(w, "\nDone fetching additional details.")
+	} else {
+		// Freshly created backups are not yet picked up by the controller; the spec below is all there is
+		fmt.Fprintln(w)
+		writeFields(w, "", []describeField{{key: "Velero Backup", value: "<not yet created>"}}, width)
+		fmt.Fprintf(w, "Note: Velero backup not yet created, so results, resource lists and logs are not available yet.\n")
 	}
 
 	// Print NonAdminBackup Spec (excluding sensitive information)
 	if nab.Spec.BackupSpec != nil {
 		specYaml, err := yaml.Marshal(nab.Spec.BackupSpec)
 		if err != nil {
-			fmt.Fprintf(cmd.OutOrStdout(), "\nSpec: <error marshaling spec: %v>\n", err)
+			fmt.Fprintf(w, "\nSpec: <error marshaling spec: %v>\n", err)
 		} else {
 			filteredSpec := filterIncludedNamespaces(string(specYaml))
-			fmt.Fprintf(cmd.OutOrStdout(), "\nSpec:\n%s", indent(filteredSpec, "  "))
+			fmt.Fprintf(w, "\nSpec:\n%s", indent(filteredSpec, "  "))
 		}
 	}
 
 	// Print NonAdminBackup Status (excluding sensitive information)
 	statusYaml, err := yaml.Marshal(nab.Status)
 	if err != nil {
-		fmt.Fprintf(cmd.OutOrStdout(), "\nStatus: <error marshaling status: %v>\n", err)
+		fmt.Fprintf(w, "\nStatus: <error marshaling status: %v>\n", err)
 	} else {
 		// Filter out includednamespaces from status output as well
 		filteredStatus := filterIncludedNamespaces(string(statusYaml))
-		fmt.Fprintf(cmd.OutOrStdout(), "\nStatus:\n%s", indent(filteredStatus, "  "))
+		fmt.Fprintf(w, "\nStatus:\n%s", indent(filteredStatus, "  "))
 	}
 
 	// Print Events for NonAdminBackup
-	fmt.Fprintf(cmd.OutOrStdout(), "\nEvents:\n")
+	fmt.Fprintf(w, "\nEvents:\n")
 	var eventList corev1.EventList
 	if err := kbClient.List(ctx, &eventList, kbclient.InNamespace(userNamespace)); err != nil {
-		fmt.Fprintf(cmd.OutOrStdout(), "  <error fetching events: %v>\n", err)
+		fmt.Fprintf(w, "  <error fetching events: %v>\n", err)
 	} else {
 		// Filter events related to this NonAdminBackup
 		var relatedEvents []corev1.Event
@@ -282,10 +337,10 @@ func NonAdminDescribeBackup(cmd *cobra.Command, kbClient kbclient.Client, nab *n
 		}
 
 		if len(relatedEvents) == 0 {
-			fmt.Fprintf(cmd.OutOrStdout(), "  <none>\n")
+			fmt.Fprintf(w, "  <none>\n")
 		} else {
 			for _, e := range relatedEvents {
-				fmt.Fprintf(cmd.OutOrStdout(), "  %s: %s\n", e.Reason, e.Message)
+				fmt.Fprintf(w, "  %s: %s\n", e.Reason, e.Message)
 			}
 		}
 	}
@@ -293,102 +348,133 @@ func NonAdminDescribeBackup(cmd *cobra.Command, kbClient kbclient.Client, nab *n
 	return nil
 }
 
-// downloadBackupData uses NonAdminDownloadRequest to fetch detailed backup information
-// This replaces direct access to Velero backups with RBAC-compliant requests
-func downloadBackupData(ctx context.Context, kbClient kbclient.Client, userNamespace, backupName, dataType string) (string, error) {
-	// Create NonAdminDownloadRequest for the specified data type
-	req := &nacv1alpha1.NonAdminDownloadRequest{
-		ObjectMeta: metav1.ObjectMeta{
-			GenerateName: backupName + "-" + strings.ToLower(dataType) + "-",
-			Namespace:    userNamespace,
-		},
-		Spec: nacv1alpha1.NonAdminDownloadRequestSpec{
-			Target: velerov1.DownloadTarget{
-				Kind: velerov1.DownloadTargetKind(dataType),
-				Name: backupName,
-			},
-		},
+// durationFields returns how long the backup took once it has completed, or how long it
+// has been running so far when it has only started
+func durationFields(status *velerov1.BackupStatus, now time.Time) []describeField {
+	if status.StartTimestamp.IsZero() {
+		return nil
+	}
+	if !status.CompletionTimestamp.IsZero() {
+		took := status.CompletionTimestamp.Sub(status.StartTimestamp.Time)
+		return []describeField{{"Duration", duration.HumanDuration(took)}}
 	}
+	return []describeField{{"Elapsed (running)", duration.HumanDuration(now.Sub(status.StartTimestamp.Time))}}
+}
 
-	if err := kbClient.Create(ctx, req); err != nil {
-		return "", fmt.Errorf("failed to create NonAdminDownloadRequest for %s: %w", dataType, err)
-	}
-
-	// Clean up the download request when done
-	defer func() {
-		deleteCtx, cancelDelete := context.WithTimeout(context.Background(), 5*time.Second)
-		defer cancelDelete()
-		_ = kbClient.Delete(deleteCtx, req)
-	}()
-
-	// Wait for the download request to be processed
-	timeout := time.After(10 * time.Second) // Reduced timeout since most failures are quick
-	tick := time.Tick(1 * time.Second)
-
-	for {
-		select {
-		case <-timeout:
-			return "", fmt.Errorf("timed out waiting for %s download request to be processed", dataType)
-		case <-tick:
-			var updated nacv1alpha1.NonAdminDownloadRequest
-			if err := kbClient.Get(ctx, kbclient.ObjectKey{
-				Namespace: req.Namespace,
-				Name:      req.Name,
-			}, &updated); err != nil {
-				return "", fmt.Errorf("failed to get NonAdminDownloadRequest: %w", err)
-			}
+// printConditions prints the conditions oldest first, so the latest transition is last
+func printConditions(w io.Writer, conditions []metav1.Condition, width int) {
+	fmt.Fprintf(w, "Conditions:\n")
+	if len(conditions) == 0 {
+		fmt.Fprintf(w, "  <none>\n")
+		return
+	}
 
-			// Check if the download request was processed successfully
-			for _, condition := range updated.Status.Conditions {
-				if condition.Type == "Processed" && condition.Status == "True" {
-					if updated.Status.VeleroDownloadRequest.Status.DownloadURL != "" {
-						// Download and return the content
-						return downloadContent(updated.Status.VeleroDownloadRequest.Status.DownloadURL)
-					}
-				}
-			}
+	sorted := make([]metav1.Condition, len(conditions))
+	copy(sorted, conditions)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].LastTransitionTime.Before(&sorted[j].LastTransitionTime)
+	})
 
-			// Check for failure conditions
-			for _, condition := range updated.Status.Conditions {
-				if condition.Status == "True" && condition.Reason == "Error" {
-					return "", fmt.Errorf("NonAdminDownloadRequest failed for %s: %s - %s", dataType, condition.Type, condition.Message)
-				}
-			}
+	for _, condition := range sorted {
+		fields := []describeField{
+			{"Type", condition.Type},
+			{"Status", string(condition.Status)},
+		}
+		if condition.Reason != "" {
+			fields = append(fields, describeField{"Reason", condition.Reason})
 		}
+		if condition.Message != "" {
+			fields = append(fields, describeField{"Message", condition.Message})
+		}
+		fields = append(fields, describeField{"Last Transition Time", condition.LastTransitionTime.Format(time.RFC3339)})
+		writeFields(w, "  ", fields, width)
+		fmt.Fprintf(w, "\n")
 	}
 }
 
-// downloadContent fetches content from a signed URL and returns it as a string
-func downloadContent(url string) (string, error) {
-	resp, err := http.Get(url)
-	if err != nil {
-		return "", fmt.Errorf("failed to download content from URL %q: %w", url, err)
+// describeField is a single "Key: value" line of describe output
+type describeField struct {
+	key   string
+	value string
+}
+
+// writeFields prints a section of fields with the values aligned after the longest key.
+// Comma-separated values that don't fit in width are wrapped, continuing under the value column.
+func writeFields(w io.Writer, indent string, fields []describeField, width int) {
+	keyWidth := 0
+	for _, field := range fields {
+		keyWidth = max(keyWidth, len(field.key))
+	}
+	// Two spaces after the longest "Key:" separate it from its value
+	valueColumn := len(indent) + keyWidth + 3
+
+	for _, field := range fields {
+		lines := wrapList(field.value, width-valueColumn)
+		fmt.Fprintf(w, "%s%-*s%s\n", indent, keyWidth+3, field.key+":", lines[0])
+		for _, line := range lines[1:] {
+			fmt.Fprintf(w, "%s%s\n", strings.Repeat(" ", valueColumn), line)
+		}
 	}
-	defer resp.Body.Close()
+}
 
-	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		return "", fmt.Errorf("failed to download content: status %s, body: %s", resp.Status, string(bodyBytes))
+// wrapList splits a comma-separated value into lines of at most width characters,
+// breaking only after a comma. Values without commas, and single items longer than
+// width, are never broken.
+func wrapList(value string, width int) []string {
+	if len(value) <= width || !strings.Contains(value, ",") {
+		return []string{value}
 	}
 
-	// Try to decompress if it's gzipped
-	var reader io.Reader = resp.Body
-	if strings.Contains(resp.Header.Get("Content-Encoding"), "gzip") {
-		gzr, err := gzip.NewReader(resp.Body)
-		if err != nil {
-			return "", fmt.Errorf("failed to create gzip reader: %w", err)
+	items := strings.Split(value, ",")
+	var lines []string
+	line := items[0]
+	for _, item := range items[1:] {
+		if len(line)+1+len(item) > width {
+			lines = append(lines, line+",")
+			line = strings.TrimLeft(item, " ")
+			continue
 		}
-		defer gzr.Close()
-		reader = gzr
+		line += "," + item
 	}
+	return append(lines, line)
+}
+
+// joinPairs formats a label or annotation map as a sorted, comma-separated key=value list
+func joinPairs(m map[string]string) string {
+	if len(m) == 0 {
+		return "<none>"
+	}
+	pairs := make([]string, 0, len(m))
+	for k, v := range m {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", k, v))
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+// terminalWidth returns the width of w when it is a terminal, and defaultDescribeWidth otherwise
+func terminalWidth(w io.Writer) int {
+	if f, ok := w.(*os.File); ok && term.IsTerminal(int(f.Fd())) {
+		if width, _, err := term.GetSize(int(f.Fd())); err == nil && width > 0 {
+			return width
+		}
+	}
+	return defaultDescribeWidth
+}
+
+// downloadBackupData uses NonAdminDownloadRequest to fetch detailed backup information
+// This replaces direct access to Velero backups with RBAC-compliant requests
+func downloadBackupData(ctx context.Context, kbClient kbclient.Client, httpClient *http.Client, userNamespace, backupName string, kind velerov1.DownloadTargetKind) (string, error) {
+	// Most failures are quick, so don't wait for the full describe timeout per data type
+	reqCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
 
-	// Read all content
-	content, err := io.ReadAll(reader)
+	url, err := shared.RequestDownloadURL(reqCtx, kbClient, userNamespace, kind, backupName, shared.DownloadRequestOptions{})
 	if err != nil {
-		return "", fmt.Errorf("failed to read content: %w", err)
+		return "", err
 	}
 
-	return string(content), nil
+	return shared.DownloadContent(httpClient, url)
 }
 
 // Helper to filter out includednamespaces from YAML output
@@ -0,0 +1,273 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+
+	"github.com/migtools/oadp-cli/cmd/shared"
+)
+
+// TestDescribeTimeoutFlag verifies the --timeout default for the summary describe
+func TestDescribeTimeoutFlag(t *testing.T) {
+	flag := NewDescribeCommand(nil, "describe").Flags().Lookup("timeout")
+	if flag == nil {
+		t.Fatal("expected describe to have a --timeout flag")
+	}
+	if flag.DefValue != defaultSummaryTimeout.String() {
+		t.Errorf("--timeout default = %s, want %s", flag.DefValue, defaultSummaryTimeout)
+	}
+}
+
+// TestDescribeBackupTimeout verifies the timeout bounds the context used to fetch the backup
+func TestDescribeBackupTimeout(t *testing.T) {
+	nab := &nacv1alpha1.NonAdminBackup{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-backup", Namespace: "my-project"},
+	}
+
+	for _, timeout := range []time.Duration{5 * time.Second, defaultDescribeTimeout} {
+		var deadline time.Time
+		var hasDeadline bool
+		client := interceptor.NewClient(newFakeClient(t, nab), interceptor.Funcs{
+			List: func(ctx context.Context, c kbclient.WithWatch, list kbclient.ObjectList, opts ...kbclient.ListOption) error {
+				deadline, hasDeadline = ctx.Deadline()
+				return c.List(ctx, list, opts...)
+			},
+		})
+
+		start := time.Now()
+		var out bytes.Buffer
+		if err := describeBackup(&out, client, "my-project", "my-backup", timeout, nil); err != nil {
+			t.Fatalf("describeBackup() error = %v", err)
+		}
+		end := time.Now()
+
+		if !hasDeadline {
+			t.Fatalf("timeout %s: expected the lookup context to have a deadline", timeout)
+		}
+		if deadline.Before(start.Add(timeout)) || deadline.After(end.Add(timeout)) {
+			t.Errorf("timeout %s: lookup deadline was %s after start", timeout, deadline.Sub(start))
+		}
+		if !strings.Contains(out.String(), "my-backup") {
+			t.Errorf("expected summary output to contain the backup name, got:\n%s", out.String())
+		}
+	}
+}
+
+// TestNonAdminDescribeBackupWithoutVeleroBackup verifies the detailed describe of a backup
+// the controller hasn't picked up yet still shows the requested spec
+func TestNonAdminDescribeBackupWithoutVeleroBackup(t *testing.T) {
+	nab := &nacv1alpha1.NonAdminBackup{
+		ObjectMeta: metav1.ObjectMeta{Name: "new-backup", Namespace: "my-project"},
+		Spec: nacv1alpha1.NonAdminBackupSpec{
+			BackupSpec: &velerov1.BackupSpec{
+				StorageLocation: "my-bsl",
+			},
+		},
+		Status: nacv1alpha1.NonAdminBackupStatus{
+			Phase: nacv1alpha1.NonAdminPhaseNew,
+		},
+	}
+
+	var out bytes.Buffer
+	if err := NonAdminDescribeBackup(context.Background(), &out, newFakeClient(t, nab), http.DefaultClient, nab, "my-project"); err != nil {
+		t.Fatalf("NonAdminDescribeBackup() error = %v", err)
+	}
+
+	for _, want := range []string{"Phase:               New", "Velero Backup:  <not yet created>", "Velero backup not yet created", "storagelocation: my-bsl"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, out.String())
+		}
+	}
+}
+
+// TestDescribeGoTemplate verifies -o go-template renders against the BackupDescription
+func TestDescribeGoTemplate(t *testing.T) {
+	nab := &nacv1alpha1.NonAdminBackup{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-backup", Namespace: "my-project"},
+		Status: nacv1alpha1.NonAdminBackupStatus{
+			Phase: nacv1alpha1.NonAdminPhaseCreated,
+			VeleroBackup: &nacv1alpha1.VeleroBackup{
+				Name:      "nab-my-backup-abc",
+				Namespace: "openshift-adp",
+				Status: &velerov1.BackupStatus{
+					Phase:    velerov1.BackupPhasePartiallyFailed,
+					Errors:   2,
+					Warnings: 1,
+				},
+			},
+		},
+	}
+
+	tmpl, err := shared.ParseGoTemplate("go-template={{.Phase}} {{.VeleroPhase}} {{.Errors}}/{{.Warnings}}")
+	if err != nil {
+		t.Fatalf("ParseGoTemplate() error = %v", err)
+	}
+
+	var out bytes.Buffer
+	if err := describeBackup(&out, newFakeClient(t, nab), "my-project", "my-backup", time.Minute, tmpl); err != nil {
+		t.Fatalf("describeBackup() error = %v", err)
+	}
+	if want := "Created PartiallyFailed 2/1"; out.String() != want {
+		t.Errorf("expected %q, got %q", want, out.String())
+	}
+}
+
+// TestDescribeConditions verifies both describe paths render conditions oldest first
+func TestDescribeConditions(t *testing.T) {
+	now := time.Now()
+	nab := &nacv1alpha1.NonAdminBackup{
+		ObjectMeta: metav1.ObjectMeta{Name: "stuck-backup", Namespace: "my-project"},
+		Status: nacv1alpha1.NonAdminBackupStatus{
+			Phase: nacv1alpha1.NonAdminPhaseBackingOff,
+			Conditions: []metav1.Condition{
+				{
+					Type:               string(nacv1alpha1.NonAdminConditionQueued),
+					Status:             metav1.ConditionFalse,
+					Reason:             "NotQueued",
+					LastTransitionTime: metav1.NewTime(now),
+				},
+				{
+					Type:               string(nacv1alpha1.NonAdminConditionAccepted),
+					Status:             metav1.ConditionFalse,
+					Reason:             "InvalidBackupSpec",
+					Message:            "spec.backupSpec.includedNamespaces can not contain namespaces other than: my-project",
+					LastTransitionTime: metav1.NewTime(now.Add(-time.Minute)),
+				},
+			},
+		},
+	}
+
+	var summary bytes.Buffer
+	if err := describeBackup(&summary, newFakeClient(t, nab), "my-project", "stuck-backup", time.Minute, nil); err != nil {
+		t.Fatalf("describeBackup() error = %v", err)
+	}
+	var detailed bytes.Buffer
+	if err := NonAdminDescribeBackup(context.Background(), &detailed, newFakeClient(t, nab), http.DefaultClient, nab, "my-project"); err != nil {
+		t.Fatalf("NonAdminDescribeBackup() error = %v", err)
+	}
+
+	for name, out := range map[string]string{"summary": summary.String(), "detailed": detailed.String()} {
+		for _, want := range []string{
+			"Conditions:",
+			"  Reason:                InvalidBackupSpec",
+			"  Message:               spec.backupSpec.includedNamespaces can not contain namespaces other than: my-project",
+		} {
+			if !strings.Contains(out, want) {
+				t.Errorf("%s: expected output to contain %q, got:\n%s", name, want, out)
+			}
+		}
+
+		accepted := strings.Index(out, "Type:                  Accepted")
+		queued := strings.Index(out, "Type:                  Queued")
+		if accepted < 0 || queued < 0 || accepted > queued {
+			t.Errorf("%s: expected conditions sorted by last transition time, got:\n%s", name, out)
+		}
+	}
+}
+
+// TestWriteFields verifies values line up after the longest key of each section
+func TestWriteFields(t *testing.T) {
+	var out bytes.Buffer
+	writeFields(&out, "  ", []describeField{
+		{"Name", "my-backup"},
+		{"Creation Timestamp", "2025-01-01T00:00:00Z"},
+		{"Phase", "Created"},
+	}, 80)
+
+	want := `  Name:                my-backup
+  Creation Timestamp:  2025-01-01T00:00:00Z
+  Phase:               Created
+`
+	if out.String() != want {
+		t.Errorf("unexpected output:\ngot:\n%s\nwant:\n%s", out.String(), want)
+	}
+}
+
+// TestWriteFieldsWrapsLists verifies long comma-separated values wrap under the value column
+func TestWriteFieldsWrapsLists(t *testing.T) {
+	var out bytes.Buffer
+	writeFields(&out, "", []describeField{
+		{"Labels", "app=frontend,team=payments,tier=web,env=production"},
+		{"Message", "a long sentence without any list separators in it"},
+	}, 40)
+
+	want := `Labels:   app=frontend,team=payments,
+          tier=web,env=production
+Message:  a long sentence without any list separators in it
+`
+	if out.String() != want {
+		t.Errorf("unexpected output:\ngot:\n%s\nwant:\n%s", out.String(), want)
+	}
+}
+
+// TestDurationFields verifies completed backups show their duration and running ones
+// show the time elapsed so far
+func TestDurationFields(t *testing.T) {
+	started := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		status velerov1.BackupStatus
+		want   []describeField
+	}{
+		{
+			name: "completed",
+			status: velerov1.BackupStatus{
+				StartTimestamp:      &metav1.Time{Time: started},
+				CompletionTimestamp: &metav1.Time{Time: started.Add(3*time.Minute + 20*time.Second)},
+			},
+			want: []describeField{{"Duration", "3m20s"}},
+		},
+		{
+			name: "in progress",
+			status: velerov1.BackupStatus{
+				StartTimestamp: &metav1.Time{Time: started},
+			},
+			want: []describeField{{"Elapsed (running)", "45s"}},
+		},
+		{
+			name: "not started",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := durationFields(&tt.status, started.Add(45*time.Second))
+			if len(got) != len(tt.want) {
+				t.Fatalf("durationFields() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("durationFields()[%d] = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"time"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BackupDescription is the describe summary of a NonAdminBackup as data, which
+// describe -o go-template templates are evaluated against
+type BackupDescription struct {
+	Name        string             `json:"name"`
+	Namespace   string             `json:"namespace"`
+	Labels      map[string]string  `json:"labels,omitempty"`
+	Annotations map[string]string  `json:"annotations,omitempty"`
+	Phase       string             `json:"phase"`
+	Conditions  []metav1.Condition `json:"conditions,omitempty"`
+
+	// The Velero backup fields are empty until the controller has created it
+	VeleroBackup   string     `json:"veleroBackup,omitempty"`
+	VeleroPhase    string     `json:"veleroPhase,omitempty"`
+	StartTime      *time.Time `json:"startTime,omitempty"`
+	CompletionTime *time.Time `json:"completionTime,omitempty"`
+	Expiration     *time.Time `json:"expiration,omitempty"`
+	Errors         int        `json:"errors"`
+	Warnings       int        `json:"warnings"`
+
+	Spec *velerov1.BackupSpec `json:"spec,omitempty"`
+}
+
+// newBackupDescription returns the description of nab
+func newBackupDescription(nab *nacv1alpha1.NonAdminBackup) BackupDescription {
+	d := BackupDescription{
+		Name:        nab.Name,
+		Namespace:   nab.Namespace,
+		Labels:      nab.Labels,
+		Annotations: nab.Annotations,
+		Phase:       string(nab.Status.Phase),
+		Conditions:  nab.Status.Conditions,
+		Spec:        nab.Spec.BackupSpec,
+	}
+
+	if nab.Status.VeleroBackup == nil {
+		return d
+	}
+	d.VeleroBackup = nab.Status.VeleroBackup.Name
+	if status := nab.Status.VeleroBackup.Status; status != nil {
+		d.VeleroPhase = string(status.Phase)
+		d.StartTime = timeOrNil(status.StartTimestamp)
+		d.CompletionTime = timeOrNil(status.CompletionTimestamp)
+		d.Expiration = timeOrNil(status.Expiration)
+		d.Errors = status.Errors
+		d.Warnings = status.Warnings
+	}
+	return d
+}
+
+// timeOrNil returns the time of t, or nil when it is unset
+func timeOrNil(t *metav1.Time) *time.Time {
+	if t == nil || t.IsZero() {
+		return nil
+	}
+	return &t.Time
+}
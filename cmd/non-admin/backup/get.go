@@ -16,8 +16,15 @@ limitations under the License.
 package backup
 
 import (
+	"bufio"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/migtools/oadp-cli/cmd/shared"
@@ -25,16 +32,38 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// wideOutput is the -o value that adds the STORAGE-LOCATION, TRANSFER-SPEED and NODE columns to the table
+const wideOutput = "wide"
+
 func NewGetCommand(f client.Factory, use string) *cobra.Command {
+	var noHeaders bool
+	var chunkSize int64
+	var watchChanges bool
+	var watchOnly bool
+	var newerThan time.Duration
+
 	c := &cobra.Command{
 		Use:   use + " [NAME]",
 		Short: "Get non-admin backup(s)",
 		Long:  "Get one or more non-admin backups",
 		Args:  cobra.MaximumNArgs(1),
+		Annotations: map[string]string{
+			shared.OutputFormatsAnnotation: "table,json,yaml," + wideOutput + "," + shared.CustomColumnsPrefix,
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
+			// -o table selects the default table, e.g. to override a default-output client config
+			if output.GetOutputFlagValue(cmd) == "table" {
+				if err := cmd.Flags().Set("output", ""); err != nil {
+					return err
+				}
+			}
+
 			// Get the current namespace from kubectl context
 			userNamespace, err := shared.GetCurrentNamespace()
 			if err != nil {
@@ -47,43 +76,115 @@ func NewGetCommand(f client.Factory, use string) *cobra.Command {
 				return err
 			}
 
+			// -o wide is the table plus the storage location, data mover transfer speed and node columns
+			wide := output.GetOutputFlagValue(cmd) == wideOutput
+			if format := output.GetOutputFlagValue(cmd); format == "" || wide {
+				shared.PrintDefaultNamespaceHint(cmd.ErrOrStderr(), userNamespace)
+			}
+
+			if watchChanges || watchOnly {
+				if newerThan > 0 {
+					return fmt.Errorf("--newer-than cannot be used with --watch")
+				}
+				format := output.GetOutputFlagValue(cmd)
+				if format != "" && format != "json" {
+					return fmt.Errorf("--watch is only supported with the default table output or -o json")
+				}
+				name := ""
+				if len(args) == 1 {
+					name = args[0]
+				}
+
+				// Stop watching on ctrl-c
+				ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+				defer stop()
+
+				if format == "json" {
+					return watchNonAdminBackupJSON(ctx, cmd.OutOrStdout(), kbClient, userNamespace, name, watchOnly)
+				}
+				return watchNonAdminBackupTable(ctx, cmd.OutOrStdout(), kbClient, userNamespace, name, noHeaders, watchOnly, output.GetLabelColumnsValues(cmd))
+			}
+
+			ctx, cancel := shared.RequestContext()
+			defer cancel()
+
 			if len(args) == 1 {
 				// Get specific backup
 				backupName := args[0]
 				var nab nacv1alpha1.NonAdminBackup
-				err := kbClient.Get(context.Background(), kbclient.ObjectKey{
+				err := kbClient.Get(ctx, kbclient.ObjectKey{
 					Namespace: userNamespace,
 					Name:      backupName,
 				}, &nab)
+				if apierrors.IsNotFound(err) {
+					return backupNotFound(ctx, kbClient, userNamespace, backupName, err)
+				}
 				if err != nil {
 					return fmt.Errorf("failed to get NonAdminBackup %q: %w", backupName, err)
 				}
 
-				if printed, err := output.PrintWithFormat(cmd, &nab); printed || err != nil {
+				if printed, err := shared.PrintWithCustomColumns(cmd, &nab, noHeaders); printed || err != nil {
 					return err
 				}
+				if !wide {
+					if printed, err := output.PrintWithFormat(cmd, &nab); printed || err != nil {
+						return err
+					}
+				}
 
 				// If no output format specified, print table format for single item
 				list := &nacv1alpha1.NonAdminBackupList{
 					Items: []nacv1alpha1.NonAdminBackup{nab},
 				}
-				return printNonAdminBackupTable(list)
+				var columns *wideColumns
+				if wide {
+					columns = newWideColumns(ctx, kbClient, userNamespace, list.Items)
+				}
+				return printNonAdminBackupTable(cmd.OutOrStdout(), list, noHeaders, output.GetLabelColumnsValues(cmd), columns)
 			} else {
+				// Stream the table a chunk at a time; other formats need the whole list
+				if chunkSize > 0 && output.GetOutputFlagValue(cmd) == "" && newerThan == 0 {
+					items, err := streamNonAdminBackupTable(ctx, cmd.OutOrStdout(), kbClient, userNamespace, chunkSize, noHeaders, output.GetLabelColumnsValues(cmd))
+					if err != nil {
+						return err
+					}
+					if !noHeaders && len(items) > 0 {
+						fmt.Fprintf(cmd.OutOrStdout(), "\n%s\n", summarizePhases(items))
+					}
+					return nil
+				}
+
 				// List all backups in namespace
 				var nabList nacv1alpha1.NonAdminBackupList
-				err := kbClient.List(context.Background(), &nabList, &kbclient.ListOptions{
+				err := kbClient.List(ctx, &nabList, &kbclient.ListOptions{
 					Namespace: userNamespace,
 				})
 				if err != nil {
 					return fmt.Errorf("failed to list NonAdminBackups: %w", err)
 				}
+				nabList.Items = shared.FilterNewerThan(nabList.Items, newerThan, time.Now())
 
-				if printed, err := output.PrintWithFormat(cmd, &nabList); printed || err != nil {
+				if printed, err := shared.PrintWithCustomColumns(cmd, &nabList, noHeaders); printed || err != nil {
 					return err
 				}
+				if !wide {
+					if printed, err := output.PrintWithFormat(cmd, &nabList); printed || err != nil {
+						return err
+					}
+				}
 
-				// Print table format
-				return printNonAdminBackupTable(&nabList)
+				// Print table format, followed by a phase summary unless headers are suppressed
+				var columns *wideColumns
+				if wide {
+					columns = newWideColumns(ctx, kbClient, userNamespace, nabList.Items)
+				}
+				if err := printNonAdminBackupTable(cmd.OutOrStdout(), &nabList, noHeaders, output.GetLabelColumnsValues(cmd), columns); err != nil {
+					return err
+				}
+				if !noHeaders && len(nabList.Items) > 0 {
+					fmt.Fprintf(cmd.OutOrStdout(), "\n%s\n", summarizePhases(nabList.Items))
+				}
+				return nil
 			}
 		},
 		Example: `  # Get all non-admin backups in the current namespace
@@ -96,57 +197,327 @@ func NewGetCommand(f client.Factory, use string) *cobra.Command {
   kubectl oadp nonadmin backup get -o yaml
 
   # Get a specific backup in JSON format
-  kubectl oadp nonadmin backup get my-backup -o json`,
+  kubectl oadp nonadmin backup get my-backup -o json
+
+  # List backup names and statuses without the header row
+  kubectl oadp nonadmin backup get --no-headers
+
+  # Show the values of the app and env labels as extra columns
+  kubectl oadp nonadmin backup get -L app,env
+
+  # Include the storage location, data mover transfer speed and upload nodes of each backup
+  kubectl oadp nonadmin backup get -o wide
+
+  # Choose the columns to print
+  kubectl oadp nonadmin backup get -o custom-columns=NAME:.metadata.name,PHASE:.status.phase
+
+  # List the backups created in the last 24 hours
+  kubectl oadp nonadmin backup get --newer-than 24h
+
+  # Print a long listing as it is fetched, 100 backups at a time
+  kubectl oadp nonadmin backup get --chunk-size 100
+
+  # Watch backups change status until ctrl-c
+  kubectl oadp nonadmin backup get --watch
+
+  # Stream every change as one JSON watch event per line, e.g. for jq
+  kubectl oadp nonadmin backup get --watch -o json
+
+  # Print only the changes from now on, without listing the current backups first
+  kubectl oadp nonadmin backup get --watch-only`,
 	}
 
+	c.Flags().BoolVar(&noHeaders, "no-headers", false, "When using the default output format, don't print headers")
+	c.Flags().BoolVarP(&watchChanges, "watch", "w", false, "After listing the backups, watch for changes and print a row each time a backup's status changes. With -o json, print every change as a JSON watch event per line")
+	c.Flags().BoolVar(&watchOnly, "watch-only", false, "Watch for changes like --watch, without printing the current backups first")
+	shared.BindNewerThanFlag(c.Flags(), &newerThan)
+	c.Flags().Int64Var(&chunkSize, "chunk-size", 0, "When using the default output format, fetch and print backups this many at a time instead of all at once (0 disables chunking)")
+
 	output.BindFlags(c.Flags())
 	output.ClearOutputFlagDefault(c)
 
 	return c
 }
 
-func printNonAdminBackupTable(nabList *nacv1alpha1.NonAdminBackupList) error {
+// printNonAdminBackupTable prints the backups as a table, with one extra column per
+// label key in labelColumns (like kubectl's -L)
+func printNonAdminBackupTable(w io.Writer, nabList *nacv1alpha1.NonAdminBackupList, noHeaders bool, labelColumns []string, wide *wideColumns) error {
 	if len(nabList.Items) == 0 {
-		fmt.Println("No non-admin backups found.")
+		fmt.Fprintln(w, "No non-admin backups found.")
 		return nil
 	}
 
-	// Print header
-	fmt.Printf("%-30s %-15s %-20s %-10s\n", "NAME", "STATUS", "CREATED", "AGE")
+	if !noHeaders {
+		printNonAdminBackupHeader(w, labelColumns, wide)
+	}
+	printNonAdminBackupRows(w, nabList.Items, labelColumns, wide)
+
+	return nil
+}
+
+// streamNonAdminBackupTable lists the backups chunkSize at a time, flushing the table to
+// out after each chunk so long listings appear as they are fetched. It returns every
+// backup listed, for the summary footer.
+func streamNonAdminBackupTable(ctx context.Context, out io.Writer, kbClient kbclient.Client, namespace string, chunkSize int64, noHeaders bool, labelColumns []string) ([]nacv1alpha1.NonAdminBackup, error) {
+	w := bufio.NewWriter(out)
+
+	var items []nacv1alpha1.NonAdminBackup
+	continueToken := ""
+	for {
+		var page nacv1alpha1.NonAdminBackupList
+		if err := kbClient.List(ctx, &page, kbclient.InNamespace(namespace), kbclient.Limit(chunkSize), kbclient.Continue(continueToken)); err != nil {
+			return nil, fmt.Errorf("failed to list NonAdminBackups: %w", err)
+		}
+
+		if len(items) == 0 && len(page.Items) > 0 && !noHeaders {
+			printNonAdminBackupHeader(w, labelColumns, nil)
+		}
+		printNonAdminBackupRows(w, page.Items, labelColumns, nil)
+		items = append(items, page.Items...)
+
+		if err := w.Flush(); err != nil {
+			return nil, err
+		}
+
+		continueToken = page.Continue
+		if continueToken == "" {
+			break
+		}
+	}
+
+	if len(items) == 0 {
+		fmt.Fprintln(out, "No non-admin backups found.")
+	}
+	return items, nil
+}
+
+// watchNonAdminBackupTable prints the backups in namespace (only name, if set), then a
+// new row each time a backup is added or its status changes, until ctx is cancelled or
+// the watch ends. With watchOnly the listed backups are not printed, only later changes.
+func watchNonAdminBackupTable(ctx context.Context, w io.Writer, kbClient kbclient.WithWatch, namespace, name string, noHeaders, watchOnly bool, labelColumns []string) error {
+	// The last printed status of each backup, so unrelated updates don't repeat rows
+	printed := make(map[string]string)
+	printChanged := func(nab *nacv1alpha1.NonAdminBackup) {
+		if name != "" && nab.Name != name {
+			return
+		}
+		status := shared.NonAdminBackupStatus(nab)
+		if last, ok := printed[nab.Name]; ok && last == status {
+			return
+		}
+		printed[nab.Name] = status
+		printNonAdminBackupRows(w, []nacv1alpha1.NonAdminBackup{*nab}, labelColumns, nil)
+	}
+
+	return listAndWatchNonAdminBackups(ctx, kbClient, namespace,
+		func(items []nacv1alpha1.NonAdminBackup) error {
+			if !noHeaders {
+				printNonAdminBackupHeader(w, labelColumns, nil)
+			}
+			for i := range items {
+				if watchOnly {
+					// Remember the listed status, so only a later change prints a row
+					printed[items[i].Name] = shared.NonAdminBackupStatus(&items[i])
+					continue
+				}
+				printChanged(&items[i])
+			}
+			return nil
+		},
+		func(eventType watch.EventType, nab *nacv1alpha1.NonAdminBackup) error {
+			if eventType == watch.Deleted {
+				delete(printed, nab.Name)
+				return nil
+			}
+			printChanged(nab)
+			return nil
+		},
+	)
+}
+
+// watchEventLine is one line of -o json --watch output, shaped like a Kubernetes watch event
+type watchEventLine struct {
+	Type   watch.EventType             `json:"type"`
+	Object *nacv1alpha1.NonAdminBackup `json:"object"`
+}
+
+// watchNonAdminBackupJSON writes one JSON watch event per line for tooling: an ADDED event
+// for each backup in namespace (only name, if set), then every event the watch delivers.
+// With watchOnly the ADDED events for the listed backups are left out.
+func watchNonAdminBackupJSON(ctx context.Context, w io.Writer, kbClient kbclient.WithWatch, namespace, name string, watchOnly bool) error {
+	encoder := json.NewEncoder(w)
+	emit := func(eventType watch.EventType, nab *nacv1alpha1.NonAdminBackup) error {
+		if name != "" && nab.Name != name {
+			return nil
+		}
+		// Typed objects returned by the API server have no TypeMeta
+		nab.SetGroupVersionKind(nacv1alpha1.GroupVersion.WithKind("NonAdminBackup"))
+		return encoder.Encode(watchEventLine{Type: eventType, Object: nab})
+	}
+
+	return listAndWatchNonAdminBackups(ctx, kbClient, namespace,
+		func(items []nacv1alpha1.NonAdminBackup) error {
+			if watchOnly {
+				return nil
+			}
+			for i := range items {
+				if err := emit(watch.Added, &items[i]); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		emit,
+	)
+}
+
+// listAndWatchNonAdminBackups lists the backups in namespace and hands them to onList, then
+// hands each added, modified or deleted backup to onEvent until ctx is cancelled, the watch
+// ends or a callback fails
+func listAndWatchNonAdminBackups(ctx context.Context, kbClient kbclient.WithWatch, namespace string, onList func([]nacv1alpha1.NonAdminBackup) error, onEvent func(watch.EventType, *nacv1alpha1.NonAdminBackup) error) error {
+	var nabList nacv1alpha1.NonAdminBackupList
+	if err := kbClient.List(ctx, &nabList, kbclient.InNamespace(namespace)); err != nil {
+		return fmt.Errorf("failed to list NonAdminBackups: %w", err)
+	}
+	if err := onList(nabList.Items); err != nil {
+		return err
+	}
+
+	// Start from the listed version so no change between the list and the watch is missed
+	watcher, err := kbClient.Watch(ctx, &nacv1alpha1.NonAdminBackupList{}, &kbclient.ListOptions{
+		Namespace: namespace,
+		Raw:       &metav1.ListOptions{ResourceVersion: nabList.ResourceVersion},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to watch NonAdminBackups: %w", err)
+	}
+	defer watcher.Stop()
 
-	// Print each backup
-	for _, nab := range nabList.Items {
-		status := getBackupStatus(&nab)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return nil
+			}
+			switch event.Type {
+			case watch.Added, watch.Modified, watch.Deleted:
+				if nab, ok := event.Object.(*nacv1alpha1.NonAdminBackup); ok {
+					if err := onEvent(event.Type, nab); err != nil {
+						return err
+					}
+				}
+			case watch.Error:
+				return fmt.Errorf("error watching NonAdminBackups: %w", apierrors.FromObject(event.Object))
+			}
+		}
+	}
+}
+
+// printNonAdminBackupHeader prints the table header row. Non-nil wide columns, as used by
+// -o wide, add the STORAGE-LOCATION, TRANSFER-SPEED and NODE columns.
+func printNonAdminBackupHeader(w io.Writer, labelColumns []string, wide *wideColumns) {
+	fmt.Fprintf(w, "%-30s %-15s %-20s %-10s", "NAME", "STATUS", "CREATED", "AGE")
+	if wide != nil {
+		fmt.Fprintf(w, " %-20s %-15s %-20s", "STORAGE-LOCATION", "TRANSFER-SPEED", "NODE")
+	}
+	for _, key := range labelColumns {
+		fmt.Fprintf(w, " %-15s", labelColumnHeader(key))
+	}
+	fmt.Fprintln(w)
+}
+
+// printNonAdminBackupRows prints one table row per backup
+func printNonAdminBackupRows(w io.Writer, items []nacv1alpha1.NonAdminBackup, labelColumns []string, wide *wideColumns) {
+	for _, nab := range items {
+		status := shared.NonAdminBackupStatus(&nab)
 		created := nab.CreationTimestamp.Format("2006-01-02 15:04:05")
-		age := formatAge(nab.CreationTimestamp.Time)
+		age := shared.FormatAge(nab.CreationTimestamp.Time)
 
-		fmt.Printf("%-30s %-15s %-20s %-10s\n", nab.Name, status, created, age)
+		fmt.Fprintf(w, "%-30s %-15s %-20s %-10s", nab.Name, status, created, age)
+		if wide != nil {
+			location := "-"
+			if nab.Spec.BackupSpec != nil && nab.Spec.BackupSpec.StorageLocation != "" {
+				location = friendlyStorageLocation(wide.locations, nab.Spec.BackupSpec.StorageLocation)
+			}
+			transfer := wide.transfers[nab.Name]
+			speed, nodes := "-", "-"
+			if transfer.Speed != "" {
+				speed = transfer.Speed
+			}
+			if len(transfer.Nodes) > 0 {
+				nodes = strings.Join(transfer.Nodes, ",")
+			}
+			fmt.Fprintf(w, " %-20s %-15s %-20s", location, speed, nodes)
+		}
+		for _, key := range labelColumns {
+			value, ok := nab.Labels[key]
+			if !ok {
+				value = "<none>"
+			}
+			fmt.Fprintf(w, " %-15s", value)
+		}
+		fmt.Fprintln(w)
 	}
+}
 
-	return nil
+// maxNameSuggestionDistance is how many edits a backup name may be from the one asked
+// for to be suggested
+const maxNameSuggestionDistance = 2
+
+// backupNotFound translates a NotFound error for the named backup, suggesting backups in
+// the namespace with similar names. Listing errors just leave out the suggestions.
+func backupNotFound(ctx context.Context, kbClient kbclient.Client, userNamespace, backupName string, err error) error {
+	var names []string
+	var nabList nacv1alpha1.NonAdminBackupList
+	if listErr := kbClient.List(ctx, &nabList, kbclient.InNamespace(userNamespace)); listErr == nil {
+		for _, nab := range nabList.Items {
+			names = append(names, nab.Name)
+		}
+	}
+	return shared.NotFoundError("backup", backupName, userNamespace, shared.Suggestions(backupName, names, maxNameSuggestionDistance), err)
 }
 
-func getBackupStatus(nab *nacv1alpha1.NonAdminBackup) string {
-	if nab.Status.Phase != "" {
-		return string(nab.Status.Phase)
+// labelColumnHeader returns the column header for a label key, e.g. APP for
+// "app" and NAME for "app.kubernetes.io/name", matching kubectl
+func labelColumnHeader(key string) string {
+	if i := strings.LastIndex(key, "/"); i >= 0 {
+		key = key[i+1:]
 	}
-	return "Unknown"
+	return strings.ToUpper(key)
 }
 
-func formatAge(t time.Time) string {
-	duration := time.Since(t)
+// summarizePhases returns a footer like "4 backups (2 Completed, 1 InProgress, 1 Failed)",
+// listing the most common statuses first
+func summarizePhases(items []nacv1alpha1.NonAdminBackup) string {
+	counts := make(map[string]int)
+	for i := range items {
+		counts[shared.NonAdminBackupStatus(&items[i])]++
+	}
+
+	statuses := make([]string, 0, len(counts))
+	for status := range counts {
+		statuses = append(statuses, status)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		if counts[statuses[i]] != counts[statuses[j]] {
+			return counts[statuses[i]] > counts[statuses[j]]
+		}
+		return statuses[i] < statuses[j]
+	})
 
-	days := int(duration.Hours() / 24)
-	hours := int(duration.Hours()) % 24
-	minutes := int(duration.Minutes()) % 60
+	parts := make([]string, len(statuses))
+	for i, status := range statuses {
+		parts[i] = fmt.Sprintf("%d %s", counts[status], status)
+	}
 
-	if days > 0 {
-		return fmt.Sprintf("%dd", days)
-	} else if hours > 0 {
-		return fmt.Sprintf("%dh", hours)
-	} else if minutes > 0 {
-		return fmt.Sprintf("%dm", minutes)
-	} else {
-		return "1m"
+	noun := "backups"
+	if len(items) == 1 {
+		noun = "backup"
+	}
+	if len(parts) == 0 {
+		return fmt.Sprintf("%d %s", len(items), noun)
 	}
+	return fmt.Sprintf("%d %s (%s)", len(items), noun, strings.Join(parts, ", "))
 }
@@ -0,0 +1,561 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+)
+
+// TestPrintNonAdminBackupTableNoHeaders verifies --no-headers suppresses only the header row
+func TestPrintNonAdminBackupTableNoHeaders(t *testing.T) {
+	list := &nacv1alpha1.NonAdminBackupList{
+		Items: []nacv1alpha1.NonAdminBackup{{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-backup", Namespace: "my-project", CreationTimestamp: metav1.Now()},
+			Status:     nacv1alpha1.NonAdminBackupStatus{Phase: nacv1alpha1.NonAdminPhaseCreated},
+		}},
+	}
+
+	for _, noHeaders := range []bool{false, true} {
+		var out bytes.Buffer
+		if err := printNonAdminBackupTable(&out, list, noHeaders, nil, nil); err != nil {
+			t.Fatalf("printNonAdminBackupTable() error = %v", err)
+		}
+
+		lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+		hasHeader := strings.HasPrefix(lines[0], "NAME")
+		if hasHeader == noHeaders {
+			t.Errorf("noHeaders=%v: header present = %v, output:\n%s", noHeaders, hasHeader, out.String())
+		}
+		if !strings.HasPrefix(lines[len(lines)-1], "my-backup") {
+			t.Errorf("noHeaders=%v: expected backup row, output:\n%s", noHeaders, out.String())
+		}
+	}
+}
+
+// TestSummarizePhases verifies the get footer counts statuses and pluralizes
+func TestSummarizePhases(t *testing.T) {
+	withPhase := func(phase velerov1.BackupPhase) nacv1alpha1.NonAdminBackup {
+		return nacv1alpha1.NonAdminBackup{
+			Status: nacv1alpha1.NonAdminBackupStatus{
+				Phase:        nacv1alpha1.NonAdminPhaseCreated,
+				VeleroBackup: &nacv1alpha1.VeleroBackup{Status: &velerov1.BackupStatus{Phase: phase}},
+			},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		items  []nacv1alpha1.NonAdminBackup
+		expect string
+	}{
+		{
+			name:   "single backup",
+			items:  []nacv1alpha1.NonAdminBackup{withPhase(velerov1.BackupPhaseCompleted)},
+			expect: "1 backup (1 Completed)",
+		},
+		{
+			name: "most common status first",
+			items: []nacv1alpha1.NonAdminBackup{
+				withPhase(velerov1.BackupPhaseInProgress),
+				withPhase(velerov1.BackupPhaseCompleted),
+				withPhase(velerov1.BackupPhaseFailed),
+				withPhase(velerov1.BackupPhaseCompleted),
+			},
+			expect: "4 backups (2 Completed, 1 Failed, 1 InProgress)",
+		},
+		{
+			name: "request phases before the Velero backup exists",
+			items: []nacv1alpha1.NonAdminBackup{
+				{Status: nacv1alpha1.NonAdminBackupStatus{Phase: nacv1alpha1.NonAdminPhaseBackingOff}},
+				{},
+			},
+			expect: "2 backups (1 BackingOff, 1 Unknown)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := summarizePhases(tt.items); got != tt.expect {
+				t.Errorf("summarizePhases() = %q, want %q", got, tt.expect)
+			}
+		})
+	}
+}
+
+// TestPrintNonAdminBackupTableLabelColumns verifies -L adds one column per label key
+func TestPrintNonAdminBackupTableLabelColumns(t *testing.T) {
+	list := &nacv1alpha1.NonAdminBackupList{
+		Items: []nacv1alpha1.NonAdminBackup{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "labeled",
+					CreationTimestamp: metav1.Now(),
+					Labels:            map[string]string{"app": "web", "app.kubernetes.io/part-of": "shop"},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "unlabeled", CreationTimestamp: metav1.Now()},
+			},
+		},
+	}
+
+	var out bytes.Buffer
+	if err := printNonAdminBackupTable(&out, list, false, []string{"app", "app.kubernetes.io/part-of"}, nil); err != nil {
+		t.Fatalf("printNonAdminBackupTable() error = %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected a header and two rows, got:\n%s", out.String())
+	}
+	if fields := strings.Fields(lines[0]); !reflect.DeepEqual(fields[len(fields)-2:], []string{"APP", "PART-OF"}) {
+		t.Errorf("expected APP and PART-OF headers, got %q", lines[0])
+	}
+	if fields := strings.Fields(lines[1]); !reflect.DeepEqual(fields[len(fields)-2:], []string{"web", "shop"}) {
+		t.Errorf("expected label values in the labeled row, got %q", lines[1])
+	}
+	if fields := strings.Fields(lines[2]); !reflect.DeepEqual(fields[len(fields)-2:], []string{"<none>", "<none>"}) {
+		t.Errorf("expected <none> for missing labels, got %q", lines[2])
+	}
+}
+
+// TestPrintNonAdminBackupTableTransferSpeed verifies -o wide shows the upload speed of data
+// mover backups with uploads in progress, and "-" for other backups
+func TestPrintNonAdminBackupTableTransferSpeed(t *testing.T) {
+	moveData := true
+	list := &nacv1alpha1.NonAdminBackupList{
+		Items: []nacv1alpha1.NonAdminBackup{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "datamover", Namespace: "my-project", CreationTimestamp: metav1.Now()},
+				Spec: nacv1alpha1.NonAdminBackupSpec{
+					BackupSpec: &velerov1.BackupSpec{SnapshotMoveData: &moveData},
+				},
+				Status: nacv1alpha1.NonAdminBackupStatus{
+					VeleroBackup: &nacv1alpha1.VeleroBackup{Name: "nab-datamover", Namespace: "openshift-adp"},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "plain", Namespace: "my-project", CreationTimestamp: metav1.Now()},
+				Status: nacv1alpha1.NonAdminBackupStatus{
+					VeleroBackup: &nacv1alpha1.VeleroBackup{Name: "nab-plain", Namespace: "openshift-adp"},
+				},
+			},
+		},
+	}
+	upload := newDataUpload("upload-1", "nab-datamover", 100<<20, time.Now().Add(-10*time.Second), 0)
+	upload.Status.Phase = velerov2alpha1.DataUploadPhaseInProgress
+	upload.Status.CompletionTimestamp = nil
+
+	transfers := transferIndex(context.Background(), newFakeClient(t, upload), list.Items)
+
+	var out bytes.Buffer
+	if err := printNonAdminBackupTable(&out, list, false, nil, &wideColumns{transfers: transfers}); err != nil {
+		t.Fatalf("printNonAdminBackupTable() error = %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected a header and two rows, got:\n%s", out.String())
+	}
+	if fields := strings.Fields(lines[0]); fields[len(fields)-2] != "TRANSFER-SPEED" {
+		t.Errorf("expected a TRANSFER-SPEED header, got %q", lines[0])
+	}
+	if fields := strings.Fields(lines[1]); fields[len(fields)-2] != "MiB/s" {
+		t.Errorf("expected a speed for the data mover backup, got %q", lines[1])
+	}
+	if fields := strings.Fields(lines[2]); fields[len(fields)-2] != "-" {
+		t.Errorf("expected - for the backup without uploads, got %q", lines[2])
+	}
+}
+
+// TestPrintNonAdminBackupTableNode verifies -o wide shows the nodes that handled a data
+// mover backup's uploads, and "-" for other backups
+func TestPrintNonAdminBackupTableNode(t *testing.T) {
+	moveData := true
+	list := &nacv1alpha1.NonAdminBackupList{
+		Items: []nacv1alpha1.NonAdminBackup{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "datamover", Namespace: "my-project", CreationTimestamp: metav1.Now()},
+				Spec: nacv1alpha1.NonAdminBackupSpec{
+					BackupSpec: &velerov1.BackupSpec{SnapshotMoveData: &moveData},
+				},
+				Status: nacv1alpha1.NonAdminBackupStatus{
+					VeleroBackup: &nacv1alpha1.VeleroBackup{Name: "nab-datamover", Namespace: "openshift-adp"},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "plain", Namespace: "my-project", CreationTimestamp: metav1.Now()},
+				Status: nacv1alpha1.NonAdminBackupStatus{
+					VeleroBackup: &nacv1alpha1.VeleroBackup{Name: "nab-plain", Namespace: "openshift-adp"},
+				},
+			},
+		},
+	}
+	start := time.Now().Add(-time.Minute)
+	var objs []kbclient.Object
+	for i, node := range []string{"worker-2", "worker-1", "worker-2"} {
+		upload := newDataUpload(fmt.Sprintf("upload-%d", i), "nab-datamover", 10<<20, start, 10*time.Second)
+		upload.Status.Node = node
+		objs = append(objs, upload)
+	}
+
+	transfers := transferIndex(context.Background(), newFakeClient(t, objs...), list.Items)
+
+	var out bytes.Buffer
+	if err := printNonAdminBackupTable(&out, list, false, nil, &wideColumns{transfers: transfers}); err != nil {
+		t.Fatalf("printNonAdminBackupTable() error = %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected a header and two rows, got:\n%s", out.String())
+	}
+	if fields := strings.Fields(lines[0]); fields[len(fields)-1] != "NODE" {
+		t.Errorf("expected a NODE header, got %q", lines[0])
+	}
+	if fields := strings.Fields(lines[1]); fields[len(fields)-1] != "worker-1,worker-2" {
+		t.Errorf("expected the upload nodes for the data mover backup, got %q", lines[1])
+	}
+	if fields := strings.Fields(lines[2]); fields[len(fields)-1] != "-" {
+		t.Errorf("expected - for the backup without uploads, got %q", lines[2])
+	}
+}
+
+// TestPrintNonAdminBackupTableStorageLocation verifies -o wide shows the NABSL name for a
+// backup whose spec holds the derived Velero BSL name, and the raw value otherwise
+func TestPrintNonAdminBackupTableStorageLocation(t *testing.T) {
+	nabsl := &nacv1alpha1.NonAdminBackupStorageLocation{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-bsl", Namespace: "my-project"},
+		Status: nacv1alpha1.NonAdminBackupStorageLocationStatus{
+			VeleroBackupStorageLocation: &nacv1alpha1.VeleroBackupStorageLocation{
+				Name:      "my-project-my-bsl-5f3c9a1e",
+				Namespace: "openshift-adp",
+			},
+		},
+	}
+	list := &nacv1alpha1.NonAdminBackupList{
+		Items: []nacv1alpha1.NonAdminBackup{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "derived", Namespace: "my-project", CreationTimestamp: metav1.Now()},
+				Spec: nacv1alpha1.NonAdminBackupSpec{
+					BackupSpec: &velerov1.BackupSpec{StorageLocation: "my-project-my-bsl-5f3c9a1e"},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "unknown", Namespace: "my-project", CreationTimestamp: metav1.Now()},
+				Spec: nacv1alpha1.NonAdminBackupSpec{
+					BackupSpec: &velerov1.BackupSpec{StorageLocation: "other-location"},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "my-project", CreationTimestamp: metav1.Now()},
+			},
+		},
+	}
+
+	columns := newWideColumns(context.Background(), newFakeClient(t, nabsl), "my-project", list.Items)
+
+	var out bytes.Buffer
+	if err := printNonAdminBackupTable(&out, list, false, nil, columns); err != nil {
+		t.Fatalf("printNonAdminBackupTable() error = %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("expected a header and three rows, got:\n%s", out.String())
+	}
+	want := []string{"STORAGE-LOCATION", "my-bsl", "other-location", "-"}
+	for i, line := range lines {
+		fields := strings.Fields(line)
+		if got := fields[len(fields)-3]; got != want[i] {
+			t.Errorf("line %d: storage location = %q, want %q in %q", i, got, want[i], line)
+		}
+	}
+}
+
+// flushRecorder records each write it receives, so buffered output shows its flush boundaries
+type flushRecorder struct {
+	writes []string
+}
+
+func (r *flushRecorder) Write(p []byte) (int, error) {
+	r.writes = append(r.writes, string(p))
+	return len(p), nil
+}
+
+// TestStreamNonAdminBackupTable verifies --chunk-size lists a page at a time and flushes
+// the rows of each page before fetching the next
+func TestStreamNonAdminBackupTable(t *testing.T) {
+	var objs []kbclient.Object
+	for i := range 5 {
+		objs = append(objs, &nacv1alpha1.NonAdminBackup{
+			ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("backup-%d", i), Namespace: "my-project"},
+		})
+	}
+
+	out := &flushRecorder{}
+	var flushesBeforeList []int
+	client := interceptor.NewClient(newFakeClient(t, objs...), interceptor.Funcs{
+		// The fake client doesn't paginate, so serve pages of Limit items using the index as the continue token
+		List: func(ctx context.Context, c kbclient.WithWatch, list kbclient.ObjectList, opts ...kbclient.ListOption) error {
+			flushesBeforeList = append(flushesBeforeList, len(out.writes))
+
+			var all nacv1alpha1.NonAdminBackupList
+			if err := c.List(ctx, &all, opts...); err != nil {
+				return err
+			}
+			listOpts := &kbclient.ListOptions{}
+			listOpts.ApplyOptions(opts)
+			start, _ := strconv.Atoi(listOpts.Continue)
+			end := min(start+int(listOpts.Limit), len(all.Items))
+
+			page := list.(*nacv1alpha1.NonAdminBackupList)
+			page.Items = all.Items[start:end]
+			if end < len(all.Items) {
+				page.Continue = strconv.Itoa(end)
+			}
+			return nil
+		},
+	})
+
+	items, err := streamNonAdminBackupTable(context.Background(), out, client, "my-project", 2, false, nil)
+	if err != nil {
+		t.Fatalf("streamNonAdminBackupTable() error = %v", err)
+	}
+	if len(items) != 5 {
+		t.Errorf("expected 5 backups returned, got %d", len(items))
+	}
+
+	if !reflect.DeepEqual(flushesBeforeList, []int{0, 1, 2}) {
+		t.Errorf("expected each page to be flushed before the next list, flushes before each list = %v", flushesBeforeList)
+	}
+	if len(out.writes) != 3 {
+		t.Fatalf("expected 3 flushes, got %d: %q", len(out.writes), out.writes)
+	}
+	for i, rows := range []int{3, 2, 1} {
+		if got := strings.Count(out.writes[i], "\n"); got != rows {
+			t.Errorf("flush %d: expected %d lines, got %d: %q", i, rows, got, out.writes[i])
+		}
+	}
+	if !strings.HasPrefix(out.writes[0], "NAME") {
+		t.Errorf("expected the header in the first flush, got %q", out.writes[0])
+	}
+}
+
+// TestWatchNonAdminBackupTable verifies watch mode prints the initial table, then a row for
+// each added backup and status change, skipping updates that don't change the status
+func TestWatchNonAdminBackupTable(t *testing.T) {
+	withPhase := func(name string, phase velerov1.BackupPhase) *nacv1alpha1.NonAdminBackup {
+		return &nacv1alpha1.NonAdminBackup{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "my-project"},
+			Status: nacv1alpha1.NonAdminBackupStatus{
+				Phase:        nacv1alpha1.NonAdminPhaseCreated,
+				VeleroBackup: &nacv1alpha1.VeleroBackup{Status: &velerov1.BackupStatus{Phase: phase}},
+			},
+		}
+	}
+
+	events := watch.NewFakeWithChanSize(5, false)
+	events.Add(withPhase("new-backup", velerov1.BackupPhaseNew))
+	events.Modify(withPhase("new-backup", velerov1.BackupPhaseNew))
+	events.Modify(withPhase("new-backup", velerov1.BackupPhaseInProgress))
+	events.Modify(withPhase("existing-backup", velerov1.BackupPhaseCompleted))
+	events.Stop()
+
+	client := interceptor.NewClient(newFakeClient(t, withPhase("existing-backup", velerov1.BackupPhaseInProgress)), interceptor.Funcs{
+		Watch: func(ctx context.Context, c kbclient.WithWatch, list kbclient.ObjectList, opts ...kbclient.ListOption) (watch.Interface, error) {
+			return events, nil
+		},
+	})
+
+	var out bytes.Buffer
+	if err := watchNonAdminBackupTable(context.Background(), &out, client, "my-project", "", false, false, nil); err != nil {
+		t.Fatalf("watchNonAdminBackupTable() error = %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	expected := [][]string{
+		{"NAME", "STATUS"},
+		{"existing-backup", "InProgress"},
+		{"new-backup", "New"},
+		{"new-backup", "InProgress"},
+		{"existing-backup", "Completed"},
+	}
+	if len(lines) != len(expected) {
+		t.Fatalf("expected %d lines, got %d:\n%s", len(expected), len(lines), out.String())
+	}
+	for i, want := range expected {
+		if fields := strings.Fields(lines[i]); fields[0] != want[0] || fields[1] != want[1] {
+			t.Errorf("line %d: expected %v, got %q", i, want, lines[i])
+		}
+	}
+}
+
+// TestWatchNonAdminBackupJSON verifies -o json --watch prints one JSON watch event per line:
+// the listed backups as ADDED, then each event from the watch
+func TestWatchNonAdminBackupJSON(t *testing.T) {
+	newBackup := func(name string, phase nacv1alpha1.NonAdminPhase) *nacv1alpha1.NonAdminBackup {
+		return &nacv1alpha1.NonAdminBackup{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "my-project"},
+			Status:     nacv1alpha1.NonAdminBackupStatus{Phase: phase},
+		}
+	}
+
+	events := watch.NewFakeWithChanSize(3, false)
+	events.Add(newBackup("new-backup", nacv1alpha1.NonAdminPhaseNew))
+	events.Modify(newBackup("new-backup", nacv1alpha1.NonAdminPhaseCreated))
+	events.Delete(newBackup("existing-backup", nacv1alpha1.NonAdminPhaseCreated))
+	events.Stop()
+
+	client := interceptor.NewClient(newFakeClient(t, newBackup("existing-backup", nacv1alpha1.NonAdminPhaseCreated)), interceptor.Funcs{
+		Watch: func(ctx context.Context, c kbclient.WithWatch, list kbclient.ObjectList, opts ...kbclient.ListOption) (watch.Interface, error) {
+			return events, nil
+		},
+	})
+
+	var out bytes.Buffer
+	if err := watchNonAdminBackupJSON(context.Background(), &out, client, "my-project", "", false); err != nil {
+		t.Fatalf("watchNonAdminBackupJSON() error = %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	expected := []struct {
+		eventType watch.EventType
+		name      string
+		phase     nacv1alpha1.NonAdminPhase
+	}{
+		{watch.Added, "existing-backup", nacv1alpha1.NonAdminPhaseCreated},
+		{watch.Added, "new-backup", nacv1alpha1.NonAdminPhaseNew},
+		{watch.Modified, "new-backup", nacv1alpha1.NonAdminPhaseCreated},
+		{watch.Deleted, "existing-backup", nacv1alpha1.NonAdminPhaseCreated},
+	}
+	if len(lines) != len(expected) {
+		t.Fatalf("expected %d lines, got %d:\n%s", len(expected), len(lines), out.String())
+	}
+	for i, want := range expected {
+		var event struct {
+			Type   watch.EventType            `json:"type"`
+			Object nacv1alpha1.NonAdminBackup `json:"object"`
+		}
+		if err := json.Unmarshal([]byte(lines[i]), &event); err != nil {
+			t.Fatalf("line %d is not a JSON event: %v\n%s", i, err, lines[i])
+		}
+		if event.Type != want.eventType || event.Object.Name != want.name || event.Object.Status.Phase != want.phase {
+			t.Errorf("line %d: expected %s %s (%s), got %s", i, want.eventType, want.name, want.phase, lines[i])
+		}
+		if event.Object.Kind != "NonAdminBackup" {
+			t.Errorf("line %d: expected the object to have its kind set, got %s", i, lines[i])
+		}
+	}
+}
+
+// TestWatchNonAdminBackupTableWatchOnly verifies --watch-only prints no rows for the listed
+// backups, only for later changes to their status or new backups
+func TestWatchNonAdminBackupTableWatchOnly(t *testing.T) {
+	withPhase := func(name string, phase velerov1.BackupPhase) *nacv1alpha1.NonAdminBackup {
+		return &nacv1alpha1.NonAdminBackup{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "my-project"},
+			Status: nacv1alpha1.NonAdminBackupStatus{
+				Phase:        nacv1alpha1.NonAdminPhaseCreated,
+				VeleroBackup: &nacv1alpha1.VeleroBackup{Status: &velerov1.BackupStatus{Phase: phase}},
+			},
+		}
+	}
+
+	events := watch.NewFakeWithChanSize(3, false)
+	events.Modify(withPhase("existing-backup", velerov1.BackupPhaseInProgress))
+	events.Modify(withPhase("existing-backup", velerov1.BackupPhaseCompleted))
+	events.Add(withPhase("new-backup", velerov1.BackupPhaseNew))
+	events.Stop()
+
+	client := interceptor.NewClient(newFakeClient(t,
+		withPhase("existing-backup", velerov1.BackupPhaseInProgress),
+		withPhase("done-backup", velerov1.BackupPhaseCompleted),
+	), interceptor.Funcs{
+		Watch: func(ctx context.Context, c kbclient.WithWatch, list kbclient.ObjectList, opts ...kbclient.ListOption) (watch.Interface, error) {
+			return events, nil
+		},
+	})
+
+	var out bytes.Buffer
+	if err := watchNonAdminBackupTable(context.Background(), &out, client, "my-project", "", false, true, nil); err != nil {
+		t.Fatalf("watchNonAdminBackupTable() error = %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	expected := [][]string{
+		{"NAME", "STATUS"},
+		{"existing-backup", "Completed"},
+		{"new-backup", "New"},
+	}
+	if len(lines) != len(expected) {
+		t.Fatalf("expected %d lines, got %d:\n%s", len(expected), len(lines), out.String())
+	}
+	for i, want := range expected {
+		if fields := strings.Fields(lines[i]); fields[0] != want[0] || fields[1] != want[1] {
+			t.Errorf("line %d: expected %v, got %q", i, want, lines[i])
+		}
+	}
+}
+
+// TestBackupNotFound verifies get NAME translates NotFound and suggests backups with
+// similar names from the namespace
+func TestBackupNotFound(t *testing.T) {
+	var objs []kbclient.Object
+	for _, name := range []string{"nightly-1", "nightly-2", "weekly"} {
+		objs = append(objs, &nacv1alpha1.NonAdminBackup{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "my-project"}})
+	}
+	kbClient := newFakeClient(t, objs...)
+
+	var nab nacv1alpha1.NonAdminBackup
+	getErr := kbClient.Get(context.Background(), kbclient.ObjectKey{Namespace: "my-project", Name: "nightly"}, &nab)
+	if !apierrors.IsNotFound(getErr) {
+		t.Fatalf("expected a NotFound error, got %v", getErr)
+	}
+
+	err := backupNotFound(context.Background(), kbClient, "my-project", "nightly", getErr)
+	want := "backup 'nightly' not found in namespace 'my-project'; did you mean 'nightly-1' or 'nightly-2'?"
+	if err.Error() != want {
+		t.Errorf("backupNotFound() = %q, want %q", err.Error(), want)
+	}
+	if !apierrors.IsNotFound(err) {
+		t.Error("expected the translated error to still be a NotFound error")
+	}
+
+	err = backupNotFound(context.Background(), kbClient, "my-project", "monthly-archive", getErr)
+	if want := "backup 'monthly-archive' not found in namespace 'my-project'"; err.Error() != want {
+		t.Errorf("backupNotFound() = %q, want %q", err.Error(), want)
+	}
+}
@@ -17,12 +17,9 @@ limitations under the License.
 */
 
 import (
-	"bufio"
-	"compress/gzip"
 	"context"
 	"fmt"
-	"io"
-	"net/http"
+	"regexp"
 	"time"
 
 	"github.com/migtools/oadp-cli/cmd/shared"
@@ -30,18 +27,31 @@ import (
 	"github.com/spf13/cobra"
 	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/client"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// logsDownloadTimeout bounds the wait for the backup logs download request to be processed
+const logsDownloadTimeout = 120 * time.Second
+
 func NewLogsCommand(f client.Factory, use string) *cobra.Command {
-	return &cobra.Command{
+	var since time.Duration
+	var match string
+	var download shared.DownloadHTTPOptions
+
+	c := &cobra.Command{
 		Use:   use + " NAME",
 		Short: "Show logs for a non-admin backup",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx, cancel := context.WithTimeout(context.Background(), 120*time.Second)
-			defer cancel()
+			// Logs are fetched whole, so filtering happens client-side
+			filter, err := buildLogFilter(since, match, time.Now())
+			if err != nil {
+				return err
+			}
+			httpClient, err := shared.NewDownloadHTTPClient(download)
+			if err != nil {
+				return err
+			}
 
 			// Get the current namespace from kubectl context
 			userNamespace, err := shared.GetCurrentNamespace()
@@ -50,8 +60,7 @@ func NewLogsCommand(f client.Factory, use string) *cobra.Command {
 			}
 			backupName := args[0]
 
-			// Create scheme with required types
-			scheme, err := shared.NewSchemeWithTypes(shared.ClientOptions{
+			kbClient, err := shared.NewClientWithScheme(f, shared.ClientOptions{
 				IncludeNonAdminTypes: true,
 				IncludeVeleroTypes:   true,
 			})
@@ -59,114 +68,86 @@ func NewLogsCommand(f client.Factory, use string) *cobra.Command {
 				return err
 			}
 
-			restConfig, err := f.ClientConfig()
-			if err != nil {
-				return fmt.Errorf("failed to get rest config: %w", err)
-			}
-			kbClient, err := kbclient.New(restConfig, kbclient.Options{Scheme: scheme})
-			if err != nil {
-				return fmt.Errorf("failed to create controller-runtime client: %w", err)
-			}
-
 			// Verify the NonAdminBackup exists before creating download request
 			var nab nacv1alpha1.NonAdminBackup
-			if err := kbClient.Get(ctx, kbclient.ObjectKey{
+			getCtx, cancelGet := shared.RequestContext()
+			defer cancelGet()
+			if err := kbClient.Get(getCtx, kbclient.ObjectKey{
 				Namespace: userNamespace,
 				Name:      backupName,
 			}, &nab); err != nil {
 				return fmt.Errorf("failed to get NonAdminBackup %q: %w", backupName, err)
 			}
 
-			req := &nacv1alpha1.NonAdminDownloadRequest{
-				ObjectMeta: metav1.ObjectMeta{
-					GenerateName: backupName + "-logs-",
-					Namespace:    userNamespace,
-				},
-				Spec: nacv1alpha1.NonAdminDownloadRequestSpec{
-					Target: velerov1.DownloadTarget{
-						Kind: "BackupLog",
-						Name: backupName, // Use NonAdminBackup name, controller will resolve to Velero backup
-					},
-				},
-			}
-
-			if err := kbClient.Create(ctx, req); err != nil {
-				return fmt.Errorf("failed to create NonAdminDownloadRequest: %w", err)
+			// A download request for logs that don't exist yet only times out
+			if err := checkBackupLogsAvailable(&nab); err != nil {
+				return err
 			}
 
-			defer func() {
-				deleteCtx, cancelDelete := context.WithTimeout(context.Background(), 5*time.Second)
-				defer cancelDelete()
-				_ = kbClient.Delete(deleteCtx, req)
-			}()
-
-			var signedURL string
-			timeout := time.After(120 * time.Second) // Increased timeout to 2 minutes
-			tick := time.Tick(2 * time.Second)       // Check every 2 seconds instead of 1
+			// Processing the download request is a wait, so it keeps its own bound
+			ctx, cancel := context.WithTimeout(context.Background(), logsDownloadTimeout)
+			defer cancel()
 
 			fmt.Fprintf(cmd.OutOrStdout(), "Waiting for backup logs to be processed...")
-		Loop:
-			for {
-				select {
-				case <-timeout:
-					return fmt.Errorf("timed out waiting for NonAdminDownloadRequest to be processed")
-				case <-tick:
+			signedURL, err := shared.RequestDownloadURL(ctx, kbClient, userNamespace, velerov1.DownloadTargetKindBackupLog, backupName, shared.DownloadRequestOptions{
+				PollInterval: 2 * time.Second,
+				OnPoll: func() {
 					fmt.Fprintf(cmd.OutOrStdout(), ".")
-					var updated nacv1alpha1.NonAdminDownloadRequest
-					if err := kbClient.Get(ctx, kbclient.ObjectKey{
-						Namespace: req.Namespace,
-						Name:      req.Name,
-					}, &updated); err != nil {
-						return fmt.Errorf("failed to get NonAdminDownloadRequest: %w", err)
-					}
-
-					// Check if the download request was processed successfully
-					for _, condition := range updated.Status.Conditions {
-						if condition.Type == "Processed" && condition.Status == "True" {
-							if updated.Status.VeleroDownloadRequest.Status.DownloadURL != "" {
-								signedURL = updated.Status.VeleroDownloadRequest.Status.DownloadURL
-								fmt.Fprintf(cmd.OutOrStdout(), "\nDownload URL received, fetching logs...\n")
-								break Loop
-							}
-						}
-					}
-
-					// Check for failure conditions
-					for _, condition := range updated.Status.Conditions {
-						if condition.Status == "True" && condition.Reason == "Error" {
-							return fmt.Errorf("NonAdminDownloadRequest failed: %s - %s", condition.Type, condition.Message)
-						}
-					}
-				}
-			}
-
-			resp, err := http.Get(signedURL)
-			if err != nil {
-				return fmt.Errorf("failed to download logs from URL %q: %w", signedURL, err)
-			}
-			defer resp.Body.Close()
-
-			if resp.StatusCode != http.StatusOK {
-				bodyBytes, _ := io.ReadAll(resp.Body)
-				return fmt.Errorf("failed to download logs: status %s, body: %s", resp.Status, string(bodyBytes))
-			}
-
-			gzr, err := gzip.NewReader(resp.Body)
+				},
+			})
 			if err != nil {
-				return fmt.Errorf("failed to create gzip reader: %w", err)
+				return err
 			}
-			defer gzr.Close()
+			fmt.Fprintf(cmd.OutOrStdout(), "\nDownload URL received, fetching logs...\n")
 
-			scanner := bufio.NewScanner(gzr)
-			for scanner.Scan() {
-				fmt.Fprintln(cmd.OutOrStdout(), scanner.Text())
-			}
-			if err := scanner.Err(); err != nil && err != io.EOF {
-				return fmt.Errorf("failed to read logs: %w", err)
+			if err := shared.StreamFilteredGzippedLines(httpClient, signedURL, cmd.OutOrStdout(), filter); err != nil {
+				return err
 			}
 
 			return nil
 		},
-		Example: `  kubectl oadp nonadmin backup logs my-backup`,
+		Example: `  kubectl oadp nonadmin backup logs my-backup
+
+  # Only show log lines from the last 30 minutes
+  kubectl oadp nonadmin backup logs my-backup --since 30m
+
+  # Only show errors from the last hour
+  kubectl oadp nonadmin backup logs my-backup --since 1h --match 'level=error'`,
 	}
+
+	c.Flags().DurationVar(&since, "since", 0, "Only show log lines newer than a relative duration like 5s, 2m, or 3h. Lines without a timestamp are always shown")
+	c.Flags().StringVar(&match, "match", "", "Only show log lines matching this regular expression. Combined with --since, lines must satisfy both")
+	download.BindFlags(c.Flags())
+
+	return c
+}
+
+// checkBackupLogsAvailable returns an error unless the Velero backup has finished, which
+// is when Velero uploads its logs
+func checkBackupLogsAvailable(nab *nacv1alpha1.NonAdminBackup) error {
+	if shared.IsBackupTerminal(shared.VeleroBackupPhase(nab)) {
+		return nil
+	}
+	return fmt.Errorf("logs are not available until the backup completes (current phase: %s)", shared.NonAdminBackupStatus(nab))
+}
+
+// buildLogFilter turns the --since and --match flags into a line filter, or nil to print every line
+func buildLogFilter(since time.Duration, match string, now time.Time) (shared.LineFilter, error) {
+	if since < 0 {
+		return nil, fmt.Errorf("--since must not be negative")
+	}
+
+	var filters []shared.LineFilter
+	if since > 0 {
+		filters = append(filters, shared.SinceFilter(now.Add(-since)))
+	}
+	if match != "" {
+		re, err := regexp.Compile(match)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --match pattern %q: %w", match, err)
+		}
+		filters = append(filters, shared.MatchFilter(re))
+	}
+
+	return shared.AllFilters(filters...), nil
 }
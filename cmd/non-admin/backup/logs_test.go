@@ -0,0 +1,174 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/migtools/oadp-cli/cmd/shared"
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+func TestBuildLogFilter(t *testing.T) {
+	now := time.Date(2025, 1, 2, 16, 0, 0, 0, time.UTC)
+	oldError := `time="2025-01-02T14:00:00Z" level=error msg="old failure"`
+	newError := `time="2025-01-02T15:45:00Z" level=error msg="new failure"`
+	newInfo := `time="2025-01-02T15:50:00Z" level=info msg="progress"`
+	lines := []string{oldError, newError, newInfo}
+
+	tests := []struct {
+		name    string
+		since   time.Duration
+		match   string
+		want    []string
+		wantErr string
+	}{
+		{name: "no filters", want: lines},
+		{name: "match only", match: "level=error", want: []string{oldError, newError}},
+		{name: "since only", since: 30 * time.Minute, want: []string{newError, newInfo}},
+		{name: "since and match", since: 30 * time.Minute, match: "level=error", want: []string{newError}},
+		{name: "invalid pattern", match: "level=(error", wantErr: "invalid --match pattern"},
+		{name: "negative since", since: -time.Minute, wantErr: "--since must not be negative"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := buildLogFilter(tt.since, tt.match, now)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("buildLogFilter() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("buildLogFilter() error = %v", err)
+			}
+
+			var got []string
+			for _, line := range lines {
+				if filter == nil || filter(line) {
+					got = append(got, line)
+				}
+			}
+			if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("filtered lines = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestCheckBackupLogsAvailable verifies logs are only requested once the Velero backup finished
+func TestCheckBackupLogsAvailable(t *testing.T) {
+	withVeleroPhase := func(phase velerov1.BackupPhase) *nacv1alpha1.NonAdminBackup {
+		return &nacv1alpha1.NonAdminBackup{
+			Status: nacv1alpha1.NonAdminBackupStatus{
+				Phase: nacv1alpha1.NonAdminPhaseCreated,
+				VeleroBackup: &nacv1alpha1.VeleroBackup{
+					Status: &velerov1.BackupStatus{Phase: phase},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		nab         *nacv1alpha1.NonAdminBackup
+		expectError string
+	}{
+		{
+			name:        "not started",
+			nab:         &nacv1alpha1.NonAdminBackup{Status: nacv1alpha1.NonAdminBackupStatus{Phase: nacv1alpha1.NonAdminPhaseNew}},
+			expectError: "logs are not available until the backup completes (current phase: New)",
+		},
+		{
+			name:        "in progress",
+			nab:         withVeleroPhase(velerov1.BackupPhaseInProgress),
+			expectError: "logs are not available until the backup completes (current phase: InProgress)",
+		},
+		{name: "completed", nab: withVeleroPhase(velerov1.BackupPhaseCompleted)},
+		{name: "partially failed", nab: withVeleroPhase(velerov1.BackupPhasePartiallyFailed)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkBackupLogsAvailable(tt.nab)
+			if tt.expectError == "" {
+				if err != nil {
+					t.Errorf("checkBackupLogsAvailable() error = %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.expectError {
+				t.Errorf("checkBackupLogsAvailable() error = %v, want %q", err, tt.expectError)
+			}
+		})
+	}
+}
+
+// TestLogsCommandUnfinishedBackup runs `logs` through cobra against a fake client and
+// verifies an unfinished backup is reported without creating a download request
+func TestLogsCommandUnfinishedBackup(t *testing.T) {
+	kubeconfig := filepath.Join(t.TempDir(), "config")
+	if err := os.WriteFile(kubeconfig, []byte(`apiVersion: v1
+kind: Config
+current-context: dev
+clusters:
+- name: dev
+  cluster:
+    server: https://dev.example.com
+contexts:
+- name: dev
+  context:
+    cluster: dev
+    namespace: my-project
+`), 0600); err != nil {
+		t.Fatalf("Failed to write kubeconfig: %v", err)
+	}
+	t.Setenv("KUBECONFIG", kubeconfig)
+
+	nab := &nacv1alpha1.NonAdminBackup{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-backup", Namespace: "my-project"},
+		Status:     nacv1alpha1.NonAdminBackupStatus{Phase: nacv1alpha1.NonAdminPhaseNew},
+	}
+	client := newFakeClient(t, nab)
+	shared.UseFakeClient(t, client)
+
+	c := NewLogsCommand(nil, "logs")
+	c.SetArgs([]string{"my-backup"})
+	c.SilenceUsage = true
+	c.SilenceErrors = true
+	err := c.Execute()
+	if err == nil || !strings.Contains(err.Error(), "logs are not available until the backup completes") {
+		t.Fatalf("Execute() error = %v, want logs not available", err)
+	}
+
+	var requests nacv1alpha1.NonAdminDownloadRequestList
+	if err := client.List(context.Background(), &requests); err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(requests.Items) != 0 {
+		t.Errorf("expected no download request, got %d", len(requests.Items))
+	}
+}
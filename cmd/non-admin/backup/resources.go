@@ -0,0 +1,114 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+
+	"github.com/migtools/oadp-cli/cmd/shared"
+)
+
+// maxResourceSuggestionDistance is how many edits a --include-resources entry may be
+// from a served resource for it to be suggested
+const maxResourceSuggestionDistance = 2
+
+// resourceIndex holds the resource names the API server serves, in the resource.group
+// forms Velero accepts for its resource filters
+type resourceIndex struct {
+	// names maps every accepted spelling (plural, singular and short names, with and
+	// without the group) to the resource's canonical plural.group name
+	names map[string]string
+}
+
+// newResourceIndex reads the served resources from discovery. Groups that fail
+// discovery, e.g. an unavailable aggregated API, are left out rather than failing.
+func newResourceIndex(discoveryClient discovery.ServerResourcesInterface) (*resourceIndex, error) {
+	_, lists, err := discoveryClient.ServerGroupsAndResources()
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return nil, fmt.Errorf("failed to discover server resources: %w", err)
+	}
+
+	index := &resourceIndex{names: make(map[string]string)}
+	for _, list := range lists {
+		gv, err := schema.ParseGroupVersion(list.GroupVersion)
+		if err != nil {
+			continue
+		}
+		for _, resource := range list.APIResources {
+			// Subresources such as pods/log can't be filtered on
+			if strings.Contains(resource.Name, "/") {
+				continue
+			}
+			canonical := schema.GroupResource{Group: gv.Group, Resource: resource.Name}.String()
+			spellings := append([]string{resource.Name, resource.SingularName, strings.ToLower(resource.Kind)}, resource.ShortNames...)
+			for _, name := range spellings {
+				if name == "" {
+					continue
+				}
+				index.names[name] = canonical
+				if gv.Group != "" {
+					index.names[name+"."+gv.Group] = canonical
+				}
+			}
+		}
+	}
+	return index, nil
+}
+
+// check returns an error for the first resource that the server doesn't serve,
+// suggesting served resources within a couple of edits of it
+func (index *resourceIndex) check(flagName string, resources []string) error {
+	for _, resource := range resources {
+		if resource == "*" {
+			continue
+		}
+		if _, ok := index.names[strings.ToLower(resource)]; ok {
+			continue
+		}
+
+		msg := fmt.Sprintf("--%s: resource %q is not served by the cluster", flagName, resource)
+		if suggestions := index.suggest(strings.ToLower(resource)); len(suggestions) > 0 {
+			msg += "; " + shared.DidYouMean(suggestions)
+		}
+		return errors.New(msg)
+	}
+	return nil
+}
+
+// suggest returns the canonical names of the resources with a spelling close to resource
+func (index *resourceIndex) suggest(resource string) []string {
+	spellings := make([]string, 0, len(index.names))
+	for name := range index.names {
+		spellings = append(spellings, name)
+	}
+
+	var suggestions []string
+	seen := make(map[string]bool)
+	for _, name := range shared.Suggestions(resource, spellings, maxResourceSuggestionDistance) {
+		canonical := index.names[name]
+		if !seen[canonical] {
+			seen[canonical] = true
+			suggestions = append(suggestions, canonical)
+		}
+	}
+	return suggestions
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/migtools/oadp-cli/cmd/shared"
+)
+
+// wideColumns holds the lookups behind the extra -o wide columns
+type wideColumns struct {
+	// locations maps Velero BSL names to NABSL names; see storageLocationNames
+	locations map[string]string
+	// transfers maps NonAdminBackup names to data mover upload speeds and nodes; see transferIndex
+	transfers map[string]shared.DataTransfer
+}
+
+// newWideColumns looks up the storage location names and data transfers of the backups
+func newWideColumns(ctx context.Context, kbClient kbclient.Client, userNamespace string, items []nacv1alpha1.NonAdminBackup) *wideColumns {
+	return &wideColumns{
+		locations: storageLocationNames(ctx, kbClient, userNamespace),
+		transfers: transferIndex(ctx, kbClient, items),
+	}
+}
+
+// storageLocationNames maps the Velero BackupStorageLocation names that the controller
+// derives for each NonAdminBackupStorageLocation in the namespace back to the NABSL name
+// the user chose. Lookup errors leave the map empty, so callers show the raw value.
+func storageLocationNames(ctx context.Context, kbClient kbclient.Client, userNamespace string) map[string]string {
+	names := make(map[string]string)
+
+	var nabsls nacv1alpha1.NonAdminBackupStorageLocationList
+	if err := kbClient.List(ctx, &nabsls, kbclient.InNamespace(userNamespace)); err != nil {
+		return names
+	}
+
+	for _, nabsl := range nabsls.Items {
+		velero := nabsl.Status.VeleroBackupStorageLocation
+		if velero == nil {
+			continue
+		}
+		if velero.Name != "" {
+			names[velero.Name] = nabsl.Name
+		}
+		if velero.NACUUID != "" {
+			names[velero.NACUUID] = nabsl.Name
+		}
+	}
+	return names
+}
+
+// friendlyStorageLocation returns the NABSL name for a storage location, or the location
+// itself when no NABSL in the namespace claims it
+func friendlyStorageLocation(names map[string]string, location string) string {
+	if name, ok := names[location]; ok {
+		return name
+	}
+	return location
+}
+
+// backupStorageLocation returns the NABSL name of the backup's storage location, or ""
+// when the backup uses the default location
+func backupStorageLocation(ctx context.Context, kbClient kbclient.Client, nab *nacv1alpha1.NonAdminBackup) string {
+	if nab.Spec.BackupSpec == nil || nab.Spec.BackupSpec.StorageLocation == "" {
+		return ""
+	}
+	return friendlyStorageLocation(storageLocationNames(ctx, kbClient, nab.Namespace), nab.Spec.BackupSpec.StorageLocation)
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package bsl
 
 import (
+	"github.com/migtools/oadp-cli/cmd/shared"
 	"github.com/spf13/cobra"
 	"github.com/vmware-tanzu/velero/pkg/client"
 )
@@ -24,9 +25,10 @@ import (
 // NewBSLCommand creates the "bsl" subcommand under nonadmin
 func NewBSLCommand(f client.Factory) *cobra.Command {
 	c := &cobra.Command{
-		Use:   "bsl",
-		Short: "Create and manage backup storage locations",
-		Long:  "Create and manage non-admin backup storage locations",
+		Use:              "bsl",
+		Short:            "Create and manage backup storage locations",
+		Long:             "Create and manage non-admin backup storage locations",
+		PersistentPreRun: shared.NonAdminPreRun(f),
 	}
 
 	c.AddCommand(
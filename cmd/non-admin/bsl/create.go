@@ -18,11 +18,19 @@ package bsl
 
 import (
 	"context"
+	"encoding/pem"
 	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/migtools/oadp-cli/cmd/shared"
@@ -33,6 +41,8 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/cmd"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
+	"github.com/vmware-tanzu/velero/pkg/util/encode"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -43,6 +53,8 @@ func NewCreateCommand(f client.Factory) *cobra.Command {
 		Use:   "create NAME",
 		Short: "Create a non-admin backup storage location",
 		Args:  cobra.ExactArgs(1),
+		// -o without --wait only prints the object, so the NAC check is skipped
+		Annotations: map[string]string{shared.PreviewAnnotation: ""},
 		Run: func(c *cobra.Command, args []string) {
 			cmd.CheckError(o.Complete(args, f))
 			cmd.CheckError(o.Validate(c, args, f))
@@ -63,6 +75,13 @@ func NewCreateCommand(f client.Factory) *cobra.Command {
     --credential cloud-credentials=cloud \
     --region us-east-1
 
+  # Create a non-admin backup storage location for Azure
+  kubectl oadp nonadmin bsl create my-storage \
+    --provider azure \
+    --bucket my-container \
+    --credential cloud-credentials=cloud \
+    --config resourceGroup=my-group,storageAccount=myaccount
+
   # Create with custom credential key
   kubectl oadp nonadmin bsl create my-storage \
     --provider aws \
@@ -70,13 +89,63 @@ func NewCreateCommand(f client.Factory) *cobra.Command {
     --credential my-secret=service-account-key \
     --region us-east-1
 
+  # Create the credential Secret from a local credentials file in the same step
+  kubectl oadp nonadmin bsl create my-storage \
+    --provider aws \
+    --bucket my-velero-bucket \
+    --from-secret-file ./credentials-velero \
+    --secret-name cloud-credentials \
+    --region us-east-1
+
+  # Fail instead of warning if another NABSL already uses the same bucket and prefix
+  kubectl oadp nonadmin bsl create my-storage \
+    --provider aws \
+    --bucket my-velero-bucket \
+    --prefix velero-backups \
+    --credential cloud-credentials=cloud \
+    --region us-east-1 \
+    --strict
+
+  # Create for an on-prem MinIO endpoint signed by a private CA
+  kubectl oadp nonadmin bsl create my-storage \
+    --provider aws \
+    --bucket my-velero-bucket \
+    --credential cloud-credentials=cloud \
+    --region minio \
+    --config s3Url=https://minio.example.com,s3ForcePathStyle=true \
+    --cacert ./ca-bundle.pem
+
+  # Register a read-only location to restore from
+  kubectl oadp nonadmin bsl create recovery-storage \
+    --provider aws \
+    --bucket shared-backups \
+    --credential cloud-credentials=cloud \
+    --region us-east-1 \
+    --access-mode ReadOnly
+
+  # Wait until an admin approves the location and it becomes available
+  kubectl oadp nonadmin bsl create my-storage \
+    --provider aws \
+    --bucket my-velero-bucket \
+    --credential cloud-credentials=cloud \
+    --region us-east-1 \
+    --wait --timeout 10m
+
   # View the YAML without creating the resource
   kubectl oadp nonadmin bsl create my-storage \
     --provider aws \
     --bucket my-bucket \
     --credential cloud-credentials=cloud \
     --region us-east-1 \
-    -o yaml`,
+    -o yaml
+
+  # Check the location against server-side validation without creating it
+  kubectl oadp nonadmin bsl create my-storage \
+    --provider aws \
+    --bucket my-bucket \
+    --credential cloud-credentials=cloud \
+    --region us-east-1 \
+    --dry-run=server`,
 	}
 
 	o.BindFlags(c.Flags())
@@ -95,13 +164,34 @@ type CreateOptions struct {
 	Credential flag.Map
 	Region     string
 	Config     map[string]string
-	client     kbclient.WithWatch
+	Strict     bool
+	CACertFile string
+	AccessMode string
+	// BackupSyncPeriod and ValidationFrequency are only set on the spec when the flags are provided
+	BackupSyncPeriod    time.Duration
+	ValidationFrequency time.Duration
+	IfNotExists         bool
+	Wait                bool
+	Timeout             time.Duration
+	DryRun              string
+	// FromSecretFile and SecretName create the credential Secret from a file instead of
+	// referencing an existing one with --credential
+	FromSecretFile string
+	SecretName     string
+	Force          bool
+	caCert         []byte
+	secretData     []byte
+	client         kbclient.WithWatch
 }
 
+// defaultWaitTimeout bounds how long --wait waits for approval and availability
+const defaultWaitTimeout = 5 * time.Minute
+
 func NewCreateOptions() *CreateOptions {
 	return &CreateOptions{
 		Credential: flag.NewMap(),
 		Config:     make(map[string]string),
+		Timeout:    defaultWaitTimeout,
 	}
 }
 
@@ -109,9 +199,21 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.StringVar(&o.Provider, "provider", "", "Storage provider (required). Examples: aws, azure, gcp")
 	flags.StringVar(&o.Bucket, "bucket", "", "Object storage bucket name (required)")
 	flags.StringVar(&o.Prefix, "prefix", "", "Prefix for backup objects in the bucket")
-	flags.Var(&o.Credential, "credential", "The credential to be used by this location as a key-value pair, where the key is the Kubernetes Secret name, and the value is the data key name within the Secret. Required, one value only.")
-	flags.StringVar(&o.Region, "region", "", "Storage region (required for some providers like AWS)")
+	flags.Var(&o.Credential, "credential", "The credential to be used by this location as a key-value pair, where the key is the Kubernetes Secret name, and the value is the data key name within the Secret. Required unless --from-secret-file is used, one value only.")
+	flags.StringVar(&o.Region, "region", "", "Storage region (required for AWS, ignored for GCP)")
 	flags.StringToStringVar(&o.Config, "config", nil, "Additional provider-specific configuration (key=value pairs)")
+	flags.StringVar(&o.CACertFile, "cacert", "", "Path to a PEM-encoded CA bundle used to verify the object storage endpoint (e.g. on-prem MinIO)")
+	flags.StringVar(&o.AccessMode, "access-mode", "", "Access mode for the backup storage location. Valid values are ReadWrite and ReadOnly (defaults to the controller default)")
+	flags.DurationVar(&o.BackupSyncPeriod, "backup-sync-period", o.BackupSyncPeriod, "How often to sync backups in object storage into the cluster. Optional. Set this to `0s` to disable sync (defaults to the controller default)")
+	flags.DurationVar(&o.ValidationFrequency, "validation-frequency", o.ValidationFrequency, "How often to verify the backup storage location is valid. Optional. Set this to `0s` to disable validation (defaults to the controller default)")
+	flags.BoolVar(&o.Strict, "strict", false, "Fail instead of warning when another NABSL already uses the same provider, bucket and prefix")
+	flags.BoolVar(&o.IfNotExists, "if-not-exists", false, "Succeed without changes if a NABSL with the same name already exists")
+	flags.BoolVar(&o.Wait, "wait", false, "Wait until the location is approved and available, or rejected")
+	flags.DurationVar(&o.Timeout, "timeout", o.Timeout, "How long to wait when using --wait")
+	flags.StringVar(&o.FromSecretFile, "from-secret-file", "", "Create the credential Secret from this credentials file, stored under the key \"cloud\", and use it for this location. Requires --secret-name; cannot be used with --credential")
+	flags.StringVar(&o.SecretName, "secret-name", "", "Name of the Secret created by --from-secret-file")
+	flags.BoolVar(&o.Force, "force", false, "Overwrite the Secret named by --secret-name if it already exists")
+	shared.BindDryRunFlag(flags, &o.DryRun)
 }
 
 func (o *CreateOptions) Complete(args []string, f client.Factory) error {
@@ -132,7 +234,7 @@ func (o *CreateOptions) Complete(args []string, f client.Factory) error {
 	}
 	o.Namespace = currentNS
 
-	return nil
+	return o.readSecretFile()
 }
 
 func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
@@ -142,18 +244,193 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 	if o.Bucket == "" {
 		return fmt.Errorf("--bucket is required")
 	}
-	if len(o.Credential.Data()) == 0 {
-		return errors.New("--credential is required")
+	if err := o.validateSecretFile(); err != nil {
+		return err
+	}
+	if o.FromSecretFile == "" && len(o.Credential.Data()) == 0 {
+		return errors.New("--credential is required, or use --from-secret-file")
 	}
 	if len(o.Credential.Data()) > 1 {
 		return errors.New("--credential can only contain 1 key/value pair")
 	}
+	if err := o.validateProviderConfig(); err != nil {
+		return err
+	}
+	if err := validateAccessMode(o.AccessMode); err != nil {
+		return err
+	}
+	if o.BackupSyncPeriod < 0 {
+		return errors.New("--backup-sync-period must be non-negative")
+	}
+	if o.ValidationFrequency < 0 {
+		return errors.New("--validation-frequency must be non-negative")
+	}
+	if o.Wait && o.Timeout <= 0 {
+		return errors.New("--timeout must be greater than 0")
+	}
+	if err := shared.ValidateDryRun(o.DryRun); err != nil {
+		return err
+	}
+	if o.DryRun == shared.DryRunServer {
+		if _, err := shared.DryRunOutputFormat(c); err != nil {
+			return err
+		}
+	}
+	if o.Wait && o.DryRun == shared.DryRunServer {
+		return errors.New("--wait cannot be used with --dry-run=server")
+	}
+	if o.CACertFile != "" {
+		caCert, err := readCACert(o.CACertFile)
+		if err != nil {
+			return err
+		}
+		o.caCert = caCert
+	}
 
 	return nil
 }
 
-func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
-	// Build config map
+// secretFileKey is the Secret data key --from-secret-file stores the credentials under,
+// matching the key the OADP cloud credential Secrets use
+const secretFileKey = "cloud"
+
+// readSecretFile reads the --from-secret-file credentials that Run stores in the
+// --secret-name Secret
+func (o *CreateOptions) readSecretFile() error {
+	if o.FromSecretFile == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(o.FromSecretFile)
+	if err != nil {
+		return fmt.Errorf("failed to read --from-secret-file: %w", err)
+	}
+	o.secretData = data
+	return nil
+}
+
+// validateSecretFile checks the flags that go with --from-secret-file and that the
+// credentials file wasn't empty
+func (o *CreateOptions) validateSecretFile() error {
+	if o.FromSecretFile == "" {
+		switch {
+		case o.SecretName != "":
+			return errors.New("--secret-name can only be used with --from-secret-file")
+		case o.Force:
+			return errors.New("--force can only be used with --from-secret-file")
+		}
+		return nil
+	}
+
+	if o.SecretName == "" {
+		return errors.New("--from-secret-file requires --secret-name")
+	}
+	if len(o.Credential.Data()) > 0 {
+		return errors.New("--credential cannot be used with --from-secret-file")
+	}
+
+	if len(o.secretData) == 0 {
+		return fmt.Errorf("--from-secret-file %q is empty", o.FromSecretFile)
+	}
+
+	return nil
+}
+
+// validateAccessMode ensures the access mode, if set, is one Velero understands
+func validateAccessMode(accessMode string) error {
+	switch velerov1.BackupStorageLocationAccessMode(accessMode) {
+	case "", velerov1.BackupStorageLocationAccessModeReadWrite, velerov1.BackupStorageLocationAccessModeReadOnly:
+		return nil
+	default:
+		return fmt.Errorf("invalid --access-mode %q, valid values are %s and %s", accessMode,
+			velerov1.BackupStorageLocationAccessModeReadWrite, velerov1.BackupStorageLocationAccessModeReadOnly)
+	}
+}
+
+// readCACert reads a CA bundle from disk and verifies it contains at least one PEM block
+func readCACert(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read --cacert file: %w", err)
+	}
+
+	if block, _ := pem.Decode(data); block == nil {
+		return nil, fmt.Errorf("--cacert file %q does not contain a PEM-encoded certificate", path)
+	}
+
+	return data, nil
+}
+
+// providerRequirement describes the config keys a provider needs or ignores
+type providerRequirement struct {
+	// required lists config keys that must be set, each with a hint on how to set it
+	required []requiredConfigKey
+	// ignored lists config keys the provider does not use; they are dropped from the spec
+	ignored []string
+}
+
+// requiredConfigKey is a config key together with the flag hint shown when it is missing
+type requiredConfigKey struct {
+	key  string
+	hint string
+}
+
+// providerRequirements holds the config requirements of common providers. Providers
+// not listed here are passed through without validation.
+var providerRequirements = map[string]providerRequirement{
+	"aws": {
+		required: []requiredConfigKey{
+			{key: "region", hint: "--region"},
+		},
+	},
+	"gcp": {
+		ignored: []string{"region"},
+	},
+	"azure": {
+		required: []requiredConfigKey{
+			{key: "resourceGroup", hint: "--config resourceGroup=..."},
+			{key: "storageAccount", hint: "--config storageAccount=..."},
+		},
+	},
+}
+
+// normalizeProvider returns the short name of a provider, so "velero.io/aws" and "aws"
+// compare equal
+func normalizeProvider(provider string) string {
+	return strings.TrimPrefix(strings.ToLower(provider), "velero.io/")
+}
+
+// requirementsForProvider looks up the requirements for a provider, accepting
+// both short ("aws") and fully-qualified ("velero.io/aws") provider names
+func requirementsForProvider(provider string) (providerRequirement, bool) {
+	req, ok := providerRequirements[normalizeProvider(provider)]
+	return req, ok
+}
+
+// validateProviderConfig checks the effective config against the provider requirements
+func (o *CreateOptions) validateProviderConfig() error {
+	req, ok := requirementsForProvider(o.Provider)
+	if !ok {
+		return nil
+	}
+
+	config := o.buildConfig()
+	var missing []string
+	for _, required := range req.required {
+		if config[required.key] == "" {
+			missing = append(missing, required.hint)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("provider %q requires %s", o.Provider, strings.Join(missing, " and "))
+	}
+
+	return nil
+}
+
+// buildConfig merges --region and --config into the BSL config, dropping keys
+// the provider ignores
+func (o *CreateOptions) buildConfig() map[string]string {
 	config := make(map[string]string)
 	if o.Region != "" {
 		config["region"] = o.Region
@@ -163,6 +440,211 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 		config[k] = v
 	}
 
+	if req, ok := requirementsForProvider(o.Provider); ok {
+		for _, key := range req.ignored {
+			delete(config, key)
+		}
+	}
+
+	return config
+}
+
+func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
+	setBackupSyncPeriod := c.Flags().Changed("backup-sync-period")
+	setValidationFrequency := c.Flags().Changed("validation-frequency")
+
+	nabsl := o.BuildNonAdminBackupStorageLocation(setBackupSyncPeriod, setValidationFrequency)
+
+	// With --dry-run=server, -o is the format of the object the server returns
+	if o.DryRun != shared.DryRunServer {
+		if printed, err := output.PrintWithFormat(c, nabsl); printed || err != nil {
+			return err
+		}
+	}
+
+	ctx, cancel := shared.RequestContext()
+	defer cancel()
+
+	// A Secret created from --from-secret-file is known to have the key; with
+	// --dry-run=server it isn't persisted, so it couldn't be read back anyway
+	if o.secretData == nil {
+		if err := o.checkCredentialSecret(ctx); err != nil {
+			return err
+		}
+	}
+
+	if err := o.checkPrefixCollision(ctx, c.OutOrStdout()); err != nil {
+		return err
+	}
+
+	// The Secret is only written once the NABSL is known not to exist, so a rerun doesn't
+	// overwrite the credential of a live location, and is put back if the create fails
+	var undoSecret func()
+	if o.secretData != nil {
+		existing := new(nacv1alpha1.NonAdminBackupStorageLocation)
+		err := o.client.Get(ctx, kbclient.ObjectKey{Namespace: o.Namespace, Name: nabsl.Name}, existing)
+		switch {
+		case err == nil:
+			return o.handleAlreadyExists(c.OutOrStdout(), nabsl.Name,
+				apierrors.NewAlreadyExists(nacv1alpha1.GroupVersion.WithResource("nonadminbackupstoragelocations").GroupResource(), nabsl.Name))
+		case !apierrors.IsNotFound(err):
+			return fmt.Errorf("failed to get NonAdminBackupStorageLocation %q: %w", nabsl.Name, err)
+		}
+
+		undoSecret, err = o.createCredentialSecret(ctx, c.OutOrStdout())
+		if err != nil {
+			return err
+		}
+	}
+
+	err := o.client.Create(ctx, nabsl, shared.DryRunCreateOptions(o.DryRun)...)
+	if err != nil {
+		if undoSecret != nil {
+			undoSecret()
+		}
+		return o.handleAlreadyExists(c.OutOrStdout(), nabsl.Name, err)
+	}
+
+	if o.DryRun == shared.DryRunServer {
+		dryRunFormat, err := shared.DryRunOutputFormat(c)
+		if err != nil {
+			return err
+		}
+		// Typed objects returned by the API server have no TypeMeta, which the encoder needs
+		nabsl.SetGroupVersionKind(nacv1alpha1.GroupVersion.WithKind("NonAdminBackupStorageLocation"))
+		return encode.To(nabsl, dryRunFormat, c.OutOrStdout())
+	}
+
+	w := c.OutOrStdout()
+	fmt.Fprintf(w, "NonAdminBackupStorageLocation %q created successfully.\n", nabsl.Name)
+	fmt.Fprintln(w, "The controller will create a request for admin approval.")
+	if !o.Wait {
+		fmt.Fprintln(w, "Use 'kubectl oadp nabsl-request get' to view auto-created requests.")
+		return nil
+	}
+
+	waitCtx, cancelWait := context.WithTimeout(context.Background(), o.Timeout)
+	defer cancelWait()
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+
+	return o.waitForNABSL(waitCtx, w, nabsl.Name, interrupt)
+}
+
+// handleAlreadyExists turns a create error for an existing NABSL into a notice with
+// --if-not-exists, and returns any other error unchanged
+func (o *CreateOptions) handleAlreadyExists(w io.Writer, name string, err error) error {
+	if o.IfNotExists && apierrors.IsAlreadyExists(err) {
+		fmt.Fprintf(w, "NonAdminBackupStorageLocation %q already exists, skipping creation.\n", name)
+		return nil
+	}
+	return err
+}
+
+// waitForNABSL waits until the NABSL is approved and its Velero BSL is available, or
+// until it is rejected, and prints the outcome
+func (o *CreateOptions) waitForNABSL(ctx context.Context, w io.Writer, name string, interrupt <-chan os.Signal) error {
+	fmt.Fprintf(w, "Waiting for NonAdminBackupStorageLocation %q to be approved and available. You may safely press ctrl-c to stop waiting.\n", name)
+
+	var last *nacv1alpha1.NonAdminBackupStorageLocation
+	failure, err := shared.WaitForPhase(ctx, o.client, &nacv1alpha1.NonAdminBackupStorageLocationList{}, o.Namespace, name,
+		func(nabsl *nacv1alpha1.NonAdminBackupStorageLocation) (bool, string) {
+			last = nabsl
+			return nabslWaitStatus(nabsl)
+		},
+		shared.WaitOptions{Interrupt: interrupt},
+	)
+	switch {
+	case errors.Is(err, shared.ErrWaitInterrupted):
+		fmt.Fprintf(w, "Stopping wait; use 'kubectl oadp nabsl-request get' to check on %q.\n", name)
+		return nil
+	case errors.Is(err, context.DeadlineExceeded):
+		phase := "Unknown"
+		if last != nil {
+			phase = nabslPhase(last)
+		}
+		return fmt.Errorf("timed out waiting for NonAdminBackupStorageLocation %q (last phase: %s)", name, phase)
+	case err != nil:
+		return err
+	}
+
+	if failure != "" {
+		if reason := o.rejectionReason(ctx, last); reason != "" {
+			failure = fmt.Sprintf("%s (reason: %s)", failure, reason)
+		}
+		return fmt.Errorf("NonAdminBackupStorageLocation %q %s", name, failure)
+	}
+
+	fmt.Fprintf(w, "NonAdminBackupStorageLocation %q is approved and available.\n", name)
+	return nil
+}
+
+// nabslWaitStatus reports whether a NABSL has reached a final state for --wait. The
+// message is empty on success (approved and available) and describes the failure otherwise.
+func nabslWaitStatus(nabsl *nacv1alpha1.NonAdminBackupStorageLocation) (bool, string) {
+	approved := meta.FindStatusCondition(nabsl.Status.Conditions, string(nacv1alpha1.NonAdminBSLConditionApproved))
+	if approved != nil && approved.Status == metav1.ConditionFalse {
+		return true, "was rejected: " + approved.Message
+	}
+
+	if nabsl.Status.Phase == nacv1alpha1.NonAdminPhaseBackingOff {
+		for _, condition := range nabsl.Status.Conditions {
+			if condition.Status == metav1.ConditionFalse {
+				return true, "could not be processed: " + condition.Message
+			}
+		}
+		return true, "could not be processed by the controller"
+	}
+
+	if approved != nil && approved.Status == metav1.ConditionTrue && nabslPhase(nabsl) == string(velerov1.BackupStorageLocationPhaseAvailable) {
+		return true, ""
+	}
+
+	return false, ""
+}
+
+// nabslPhase returns the Velero BSL phase once the NABSL has one, else the NABSL phase
+func nabslPhase(nabsl *nacv1alpha1.NonAdminBackupStorageLocation) string {
+	if bsl := nabsl.Status.VeleroBackupStorageLocation; bsl != nil && bsl.Status != nil && bsl.Status.Phase != "" {
+		return string(bsl.Status.Phase)
+	}
+	if nabsl.Status.Phase != "" {
+		return string(nabsl.Status.Phase)
+	}
+	return "Unknown"
+}
+
+// rejectionReason looks up the reason an admin gave when rejecting the NABSL request.
+// The request lives in the OADP namespace next to the Velero BSL, so this is best
+// effort and returns "" if the user can't read it.
+func (o *CreateOptions) rejectionReason(ctx context.Context, nabsl *nacv1alpha1.NonAdminBackupStorageLocation) string {
+	if nabsl == nil || nabsl.Status.VeleroBackupStorageLocation == nil {
+		return ""
+	}
+	bsl := nabsl.Status.VeleroBackupStorageLocation
+	if bsl.NACUUID == "" || bsl.Namespace == "" {
+		return ""
+	}
+
+	var request nacv1alpha1.NonAdminBackupStorageLocationRequest
+	if err := o.client.Get(ctx, kbclient.ObjectKey{
+		Name:      bsl.NACUUID,
+		Namespace: bsl.Namespace,
+	}, &request); err != nil {
+		return ""
+	}
+	return request.Annotations[shared.NABSLRejectionReasonAnnotation]
+}
+
+// BuildNonAdminBackupStorageLocation builds the NABSL described by the options.
+// The sync period and validation frequency are only set when requested so the
+// controller defaults apply otherwise.
+func (o *CreateOptions) BuildNonAdminBackupStorageLocation(setBackupSyncPeriod, setValidationFrequency bool) *nacv1alpha1.NonAdminBackupStorageLocation {
+	// Build config map
+	config := o.buildConfig()
+
 	// Create the NABSL
 	nabsl := &nacv1alpha1.NonAdminBackupStorageLocation{
 		ObjectMeta: metav1.ObjectMeta{
@@ -171,35 +653,169 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 		},
 		Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{
 			BackupStorageLocationSpec: &velerov1.BackupStorageLocationSpec{
-				Provider: o.Provider,
-				Config:   config,
+				Provider:   o.Provider,
+				Config:     config,
+				AccessMode: velerov1.BackupStorageLocationAccessMode(o.AccessMode),
 				StorageType: velerov1.StorageType{
 					ObjectStorage: &velerov1.ObjectStorageLocation{
 						Bucket: o.Bucket,
 						Prefix: o.Prefix,
+						CACert: o.caCert,
 					},
 				},
 			},
 		},
 	}
 
-	// Set credential from user-provided key-value pair
+	// Set credential from the --from-secret-file Secret or the user-provided key-value pair
+	if o.FromSecretFile != "" {
+		nabsl.Spec.BackupStorageLocationSpec.Credential = builder.ForSecretKeySelector(o.SecretName, secretFileKey).Result()
+	}
 	for secretName, secretKey := range o.Credential.Data() {
 		nabsl.Spec.BackupStorageLocationSpec.Credential = builder.ForSecretKeySelector(secretName, secretKey).Result()
 		break
 	}
 
-	if printed, err := output.PrintWithFormat(c, nabsl); printed || err != nil {
-		return err
+	if setBackupSyncPeriod {
+		nabsl.Spec.BackupStorageLocationSpec.BackupSyncPeriod = &metav1.Duration{Duration: o.BackupSyncPeriod}
+	}
+	if setValidationFrequency {
+		nabsl.Spec.BackupStorageLocationSpec.ValidationFrequency = &metav1.Duration{Duration: o.ValidationFrequency}
+	}
+
+	return nabsl
+}
+
+// checkCredentialSecret verifies the --credential Secret exists in the namespace and
+// holds the referenced key, so a typo fails here rather than in BSL validation later
+func (o *CreateOptions) checkCredentialSecret(ctx context.Context) error {
+	for secretName, secretKey := range o.Credential.Data() {
+		secret := new(corev1.Secret)
+		if err := o.client.Get(ctx, kbclient.ObjectKey{Namespace: o.Namespace, Name: secretName}, secret); err != nil {
+			if apierrors.IsNotFound(err) {
+				return fmt.Errorf("secret %q not found in namespace %q", secretName, o.Namespace)
+			}
+			return fmt.Errorf("failed to get secret %q: %w", secretName, err)
+		}
+		if _, ok := secret.Data[secretKey]; !ok {
+			return fmt.Errorf("key %q not found in secret %q", secretKey, secretName)
+		}
+	}
+
+	return nil
+}
+
+// createCredentialSecret creates the --secret-name Secret from the --from-secret-file
+// credentials, overwriting an existing Secret only with --force. undo reverts a persisted
+// change, deleting a new Secret or restoring an overwritten one, so the caller can clean
+// up when the NABSL create fails; it is nil when nothing was persisted.
+func (o *CreateOptions) createCredentialSecret(ctx context.Context, w io.Writer) (undo func(), err error) {
+	persisted := o.DryRun != shared.DryRunServer
+
+	existing := new(corev1.Secret)
+	err = o.client.Get(ctx, kbclient.ObjectKey{Namespace: o.Namespace, Name: o.SecretName}, existing)
+	switch {
+	case err == nil:
+		if !o.Force {
+			return nil, fmt.Errorf("secret %q already exists in namespace %q; use --force to overwrite it", o.SecretName, o.Namespace)
+		}
+		previous := existing.Data
+		existing.Data = map[string][]byte{secretFileKey: o.secretData}
+		if err := o.client.Update(ctx, existing, shared.DryRunUpdateOptions(o.DryRun)...); err != nil {
+			return nil, fmt.Errorf("failed to update secret %q: %w", o.SecretName, err)
+		}
+		fmt.Fprintf(w, "Secret %q updated with the credentials from %s.\n", o.SecretName, o.FromSecretFile)
+		if !persisted {
+			return nil, nil
+		}
+		return func() { o.restoreCredentialSecret(ctx, w, previous) }, nil
+	case !apierrors.IsNotFound(err):
+		return nil, fmt.Errorf("failed to get secret %q: %w", o.SecretName, err)
 	}
 
-	err := o.client.Create(context.Background(), nabsl)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      o.SecretName,
+			Namespace: o.Namespace,
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{secretFileKey: o.secretData},
+	}
+	if err := o.client.Create(ctx, secret, shared.DryRunCreateOptions(o.DryRun)...); err != nil {
+		return nil, fmt.Errorf("failed to create secret %q: %w", o.SecretName, err)
+	}
+	fmt.Fprintf(w, "Secret %q created from %s.\n", o.SecretName, o.FromSecretFile)
+	if !persisted {
+		return nil, nil
+	}
+	return func() { o.deleteCredentialSecret(ctx, w) }, nil
+}
+
+// deleteCredentialSecret removes the Secret createCredentialSecret created, so a failed
+// create doesn't leave it orphaned. Failing to delete it is reported but not fatal.
+func (o *CreateOptions) deleteCredentialSecret(ctx context.Context, w io.Writer) {
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: o.SecretName, Namespace: o.Namespace}}
+	if err := o.client.Delete(ctx, secret); err != nil && !apierrors.IsNotFound(err) {
+		fmt.Fprintf(w, "Warning: failed to delete secret %q after the create failed: %v\n", o.SecretName, err)
+		return
+	}
+	fmt.Fprintf(w, "Secret %q deleted since the NonAdminBackupStorageLocation was not created.\n", o.SecretName)
+}
+
+// restoreCredentialSecret puts back the data of the Secret createCredentialSecret
+// overwrote with --force, so a failed create doesn't lose the previous credentials.
+// Failing to restore it is reported but not fatal.
+func (o *CreateOptions) restoreCredentialSecret(ctx context.Context, w io.Writer, data map[string][]byte) {
+	secret := new(corev1.Secret)
+	err := o.client.Get(ctx, kbclient.ObjectKey{Namespace: o.Namespace, Name: o.SecretName}, secret)
+	if err == nil {
+		secret.Data = data
+		err = o.client.Update(ctx, secret)
+	}
 	if err != nil {
-		return err
+		fmt.Fprintf(w, "Warning: failed to restore the previous credentials of secret %q after the create failed: %v\n", o.SecretName, err)
+		return
+	}
+	fmt.Fprintf(w, "Secret %q restored to its previous credentials since the NonAdminBackupStorageLocation was not created.\n", o.SecretName)
+}
+
+// checkPrefixCollision warns (or errors with --strict) when another NABSL in the
+// namespace already points at the same provider, bucket and prefix
+func (o *CreateOptions) checkPrefixCollision(ctx context.Context, w io.Writer) error {
+	var nabslList nacv1alpha1.NonAdminBackupStorageLocationList
+	if err := o.client.List(ctx, &nabslList, kbclient.InNamespace(o.Namespace)); err != nil {
+		return fmt.Errorf("failed to list NonAdminBackupStorageLocations: %w", err)
+	}
+
+	for _, existing := range nabslList.Items {
+		if existing.Name == o.Name {
+			continue
+		}
+		spec := existing.Spec.BackupStorageLocationSpec
+		if spec == nil || spec.ObjectStorage == nil {
+			continue
+		}
+		if normalizeProvider(spec.Provider) != normalizeProvider(o.Provider) || spec.ObjectStorage.Bucket != o.Bucket || spec.ObjectStorage.Prefix != o.Prefix {
+			continue
+		}
+
+		suggestion := suggestUniquePrefix(o.Prefix, o.Name)
+		if o.Strict {
+			return fmt.Errorf("NonAdminBackupStorageLocation %q already uses bucket %q with prefix %q; use a unique prefix such as --prefix %s",
+				existing.Name, o.Bucket, o.Prefix, suggestion)
+		}
+		fmt.Fprintf(w, "WARNING: NonAdminBackupStorageLocation %q already uses bucket %q with prefix %q.\n", existing.Name, o.Bucket, o.Prefix)
+		fmt.Fprintf(w, "Backups from both locations will collide. Consider using --prefix %s\n", suggestion)
+		return nil
 	}
 
-	fmt.Printf("NonAdminBackupStorageLocation %q created successfully.\n", nabsl.Name)
-	fmt.Printf("The controller will create a request for admin approval.\n")
-	fmt.Printf("Use 'kubectl oadp nonadmin bsl request get' to view auto-created requests.\n")
 	return nil
 }
+
+// suggestUniquePrefix appends the NABSL name to the prefix so it is unique within the namespace
+func suggestUniquePrefix(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return strings.TrimSuffix(prefix, "/") + "-" + name
+}
@@ -0,0 +1,942 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bsl
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/migtools/oadp-cli/cmd/shared"
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+)
+
+// newFakeClient returns a fake client seeded with the given objects
+func newFakeClient(t *testing.T, objs ...kbclient.Object) kbclient.WithWatch {
+	t.Helper()
+
+	scheme, err := shared.NewSchemeWithTypes(shared.ClientOptions{
+		IncludeNonAdminTypes: true,
+		IncludeVeleroTypes:   true,
+		IncludeCoreTypes:     true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to build scheme: %v", err)
+	}
+
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+// newNABSL returns a NABSL pointing at the given provider, bucket and prefix
+func newNABSL(name, namespace, provider, bucket, prefix string) *nacv1alpha1.NonAdminBackupStorageLocation {
+	return &nacv1alpha1.NonAdminBackupStorageLocation{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{
+			BackupStorageLocationSpec: &velerov1.BackupStorageLocationSpec{
+				Provider: provider,
+				StorageType: velerov1.StorageType{
+					ObjectStorage: &velerov1.ObjectStorageLocation{
+						Bucket: bucket,
+						Prefix: prefix,
+					},
+				},
+			},
+		},
+	}
+}
+
+// TestCheckPrefixCollision verifies the warning and --strict error for colliding NABSLs
+func TestCheckPrefixCollision(t *testing.T) {
+	existing := newNABSL("existing", "my-project", "aws", "my-bucket", "velero")
+
+	tests := []struct {
+		name       string
+		provider   string
+		prefix     string
+		strict     bool
+		expectErr  bool
+		expectWarn bool
+		expectHint string
+	}{
+		{
+			name:       "colliding prefix warns",
+			prefix:     "velero",
+			expectWarn: true,
+			expectHint: "--prefix velero-new-storage",
+		},
+		{
+			name:       "colliding prefix errors with strict",
+			prefix:     "velero",
+			strict:     true,
+			expectErr:  true,
+			expectHint: "--prefix velero-new-storage",
+		},
+		{
+			name:       "fully-qualified provider collides with the short name",
+			provider:   "velero.io/aws",
+			prefix:     "velero",
+			expectWarn: true,
+			expectHint: "--prefix velero-new-storage",
+		},
+		{
+			name:     "different provider is accepted",
+			provider: "gcp",
+			prefix:   "velero",
+			strict:   true,
+		},
+		{
+			name:   "different prefix is accepted",
+			prefix: "other",
+			strict: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := NewCreateOptions()
+			o.Name = "new-storage"
+			o.Namespace = "my-project"
+			o.Provider = "aws"
+			if tt.provider != "" {
+				o.Provider = tt.provider
+			}
+			o.Bucket = "my-bucket"
+			o.Prefix = tt.prefix
+			o.Strict = tt.strict
+			o.client = newFakeClient(t, existing)
+
+			var out bytes.Buffer
+			err := o.checkPrefixCollision(context.Background(), &out)
+
+			if tt.expectErr {
+				if err == nil {
+					t.Fatalf("Expected error, got none")
+				}
+				if !strings.Contains(err.Error(), tt.expectHint) {
+					t.Errorf("Expected error to contain %q, got %q", tt.expectHint, err.Error())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if tt.expectWarn {
+				if !strings.Contains(out.String(), "WARNING") || !strings.Contains(out.String(), tt.expectHint) {
+					t.Errorf("Expected warning with %q, got %q", tt.expectHint, out.String())
+				}
+			} else if out.Len() != 0 {
+				t.Errorf("Expected no warning, got %q", out.String())
+			}
+		})
+	}
+}
+
+// TestValidateProviderConfig verifies provider-specific config requirements
+func TestValidateProviderConfig(t *testing.T) {
+	tests := []struct {
+		name      string
+		provider  string
+		region    string
+		config    map[string]string
+		expectErr string
+	}{
+		{
+			name:      "aws without region",
+			provider:  "aws",
+			expectErr: `provider "aws" requires --region`,
+		},
+		{
+			name:     "aws with region",
+			provider: "aws",
+			region:   "us-east-1",
+		},
+		{
+			name:     "aws with region in config",
+			provider: "velero.io/aws",
+			config:   map[string]string{"region": "us-east-1"},
+		},
+		{
+			name:     "gcp without region",
+			provider: "gcp",
+		},
+		{
+			name:      "azure without config",
+			provider:  "azure",
+			expectErr: `provider "azure" requires --config resourceGroup=... and --config storageAccount=...`,
+		},
+		{
+			name:      "azure missing storage account",
+			provider:  "azure",
+			config:    map[string]string{"resourceGroup": "my-group"},
+			expectErr: `provider "azure" requires --config storageAccount=...`,
+		},
+		{
+			name:     "azure with required config",
+			provider: "azure",
+			config:   map[string]string{"resourceGroup": "my-group", "storageAccount": "myaccount"},
+		},
+		{
+			name:     "unknown provider is not validated",
+			provider: "example.com/custom",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := NewCreateOptions()
+			o.Provider = tt.provider
+			o.Bucket = "my-bucket"
+			o.Region = tt.region
+			if tt.config != nil {
+				o.Config = tt.config
+			}
+			if err := o.Credential.Set("cloud-credentials=cloud"); err != nil {
+				t.Fatalf("Failed to set credential: %v", err)
+			}
+
+			err := o.Validate(nil, []string{"my-storage"}, nil)
+			if tt.expectErr == "" {
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.expectErr {
+				t.Fatalf("Expected error %q, got %v", tt.expectErr, err)
+			}
+		})
+	}
+}
+
+// TestBuildConfigIgnoresRegionForGCP verifies region is dropped for GCP
+func TestBuildConfigIgnoresRegionForGCP(t *testing.T) {
+	o := NewCreateOptions()
+	o.Provider = "gcp"
+	o.Region = "us-central1"
+	o.Config = map[string]string{"project": "my-project"}
+
+	config := o.buildConfig()
+	if _, ok := config["region"]; ok {
+		t.Errorf("Expected region to be ignored for gcp, got config %v", config)
+	}
+	if config["project"] != "my-project" {
+		t.Errorf("Expected project config to be kept, got config %v", config)
+	}
+}
+
+// testCACert is a throwaway self-signed certificate used only to exercise PEM parsing
+const testCACert = `-----BEGIN CERTIFICATE-----
+MIIBVzCB/qADAgECAgEBMAoGCCqGSM49BAMCMBIxEDAOBgNVBAMTB3Rlc3QtY2Ew
+HhcNMjUwMTAxMDAwMDAwWhcNMzUwMTAxMDAwMDAwWjASMRAwDgYDVQQDEwd0ZXN0
+LWNhMFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAEAAAAAAAAAAAAAAAAAAAAAAAA
+AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
+o0IwQDAOBgNVHQ8BAf8EBAMCAgQwDwYDVR0TAQH/BAUwAwEB/zAdBgNVHQ4EFgQU
+AAAAAAAAAAAAAAAAAAAAAAAAAAAwCgYIKoZIzj0EAwIDSAAwRQIhAAAAAAAAAAAA
+AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAiAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
+AAAAAAAAAAAAAA==
+-----END CERTIFICATE-----
+`
+
+// TestCACertFlag verifies --cacert embeds the PEM bundle and rejects non-PEM files
+func TestCACertFlag(t *testing.T) {
+	dir := t.TempDir()
+
+	t.Run("PEM file is embedded", func(t *testing.T) {
+		path := filepath.Join(dir, "ca.pem")
+		if err := os.WriteFile(path, []byte(testCACert), 0600); err != nil {
+			t.Fatalf("Failed to write CA file: %v", err)
+		}
+
+		o := NewCreateOptions()
+		o.Name = "my-storage"
+		o.Provider = "aws"
+		o.Bucket = "my-bucket"
+		o.Region = "minio"
+		o.CACertFile = path
+		if err := o.Credential.Set("cloud-credentials=cloud"); err != nil {
+			t.Fatalf("Failed to set credential: %v", err)
+		}
+
+		if err := o.Validate(nil, []string{"my-storage"}, nil); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		nabsl := o.BuildNonAdminBackupStorageLocation(false, false)
+		if got := string(nabsl.Spec.BackupStorageLocationSpec.ObjectStorage.CACert); got != testCACert {
+			t.Errorf("Expected CACert to contain the PEM bundle, got %q", got)
+		}
+	})
+
+	t.Run("non-PEM file is rejected", func(t *testing.T) {
+		path := filepath.Join(dir, "not-a-cert.txt")
+		if err := os.WriteFile(path, []byte("not a certificate"), 0600); err != nil {
+			t.Fatalf("Failed to write file: %v", err)
+		}
+
+		if _, err := readCACert(path); err == nil || !strings.Contains(err.Error(), "does not contain a PEM-encoded certificate") {
+			t.Errorf("Expected PEM parse error, got %v", err)
+		}
+	})
+
+	t.Run("missing file is rejected", func(t *testing.T) {
+		if _, err := readCACert(filepath.Join(dir, "missing.pem")); err == nil {
+			t.Errorf("Expected error for missing file")
+		}
+	})
+}
+
+// TestAccessMode verifies --access-mode validation and that it is set on the spec
+func TestAccessMode(t *testing.T) {
+	tests := []struct {
+		name       string
+		accessMode string
+		expectErr  bool
+	}{
+		{name: "unset", accessMode: ""},
+		{name: "read write", accessMode: "ReadWrite"},
+		{name: "read only", accessMode: "ReadOnly"},
+		{name: "invalid", accessMode: "WriteOnly", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateAccessMode(tt.accessMode)
+			if tt.expectErr {
+				if err == nil {
+					t.Fatalf("Expected error for access mode %q", tt.accessMode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			o := NewCreateOptions()
+			o.Provider = "aws"
+			o.AccessMode = tt.accessMode
+			nabsl := o.BuildNonAdminBackupStorageLocation(false, false)
+			if got := string(nabsl.Spec.BackupStorageLocationSpec.AccessMode); got != tt.accessMode {
+				t.Errorf("Expected access mode %q, got %q", tt.accessMode, got)
+			}
+		})
+	}
+}
+
+// TestSyncPeriodAndValidationFrequency verifies the duration pointers are only set when requested
+func TestSyncPeriodAndValidationFrequency(t *testing.T) {
+	o := NewCreateOptions()
+	o.Provider = "aws"
+	o.BackupSyncPeriod = 5 * time.Minute
+	o.ValidationFrequency = 0
+
+	unset := o.BuildNonAdminBackupStorageLocation(false, false)
+	if unset.Spec.BackupStorageLocationSpec.BackupSyncPeriod != nil {
+		t.Errorf("Expected BackupSyncPeriod to be nil when the flag is not provided")
+	}
+	if unset.Spec.BackupStorageLocationSpec.ValidationFrequency != nil {
+		t.Errorf("Expected ValidationFrequency to be nil when the flag is not provided")
+	}
+
+	set := o.BuildNonAdminBackupStorageLocation(true, true)
+	if got := set.Spec.BackupStorageLocationSpec.BackupSyncPeriod; got == nil || got.Duration != 5*time.Minute {
+		t.Errorf("Expected BackupSyncPeriod of 5m, got %v", got)
+	}
+	// An explicit 0s disables validation, so it must still be set
+	if got := set.Spec.BackupStorageLocationSpec.ValidationFrequency; got == nil || got.Duration != 0 {
+		t.Errorf("Expected ValidationFrequency of 0s, got %v", got)
+	}
+}
+
+// TestNegativeDurationsRejected verifies negative durations fail validation
+func TestNegativeDurationsRejected(t *testing.T) {
+	for _, flagName := range []string{"backup-sync-period", "validation-frequency"} {
+		t.Run(flagName, func(t *testing.T) {
+			o := NewCreateOptions()
+			o.Provider = "gcp"
+			o.Bucket = "my-bucket"
+			if err := o.Credential.Set("cloud-credentials=cloud"); err != nil {
+				t.Fatalf("Failed to set credential: %v", err)
+			}
+			if flagName == "backup-sync-period" {
+				o.BackupSyncPeriod = -time.Minute
+			} else {
+				o.ValidationFrequency = -time.Minute
+			}
+
+			err := o.Validate(nil, []string{"my-storage"}, nil)
+			if err == nil || !strings.Contains(err.Error(), flagName) {
+				t.Errorf("Expected error mentioning %s, got %v", flagName, err)
+			}
+		})
+	}
+}
+
+// TestWaitForNABSL drives a NABSL through status transitions and verifies --wait
+// reports availability, rejection (with the admin's reason) and timeouts
+func TestWaitForNABSL(t *testing.T) {
+	approved := metav1.Condition{
+		Type:   string(nacv1alpha1.NonAdminBSLConditionApproved),
+		Status: metav1.ConditionTrue,
+		Reason: "Approved",
+	}
+	rejected := metav1.Condition{
+		Type:    string(nacv1alpha1.NonAdminBSLConditionApproved),
+		Status:  metav1.ConditionFalse,
+		Reason:  "Rejected",
+		Message: "request was rejected by the cluster administrator",
+	}
+
+	tests := []struct {
+		name        string
+		transitions []nacv1alpha1.NonAdminBackupStorageLocationStatus
+		timeout     time.Duration
+		expectErr   string
+		expectOut   string
+	}{
+		{
+			name: "approved and available",
+			transitions: []nacv1alpha1.NonAdminBackupStorageLocationStatus{
+				{Phase: nacv1alpha1.NonAdminPhaseNew},
+				{
+					Phase:      nacv1alpha1.NonAdminPhaseCreated,
+					Conditions: []metav1.Condition{approved},
+					VeleroBackupStorageLocation: &nacv1alpha1.VeleroBackupStorageLocation{
+						NACUUID: "my-uuid",
+						Status:  &velerov1.BackupStorageLocationStatus{Phase: velerov1.BackupStorageLocationPhaseAvailable},
+					},
+				},
+			},
+			timeout:   10 * time.Second,
+			expectOut: `NonAdminBackupStorageLocation "my-storage" is approved and available.`,
+		},
+		{
+			name: "rejected",
+			transitions: []nacv1alpha1.NonAdminBackupStorageLocationStatus{
+				{
+					Phase:      nacv1alpha1.NonAdminPhaseBackingOff,
+					Conditions: []metav1.Condition{rejected},
+					VeleroBackupStorageLocation: &nacv1alpha1.VeleroBackupStorageLocation{
+						NACUUID:   "my-uuid",
+						Namespace: "openshift-adp",
+					},
+				},
+			},
+			timeout:   10 * time.Second,
+			expectErr: `NonAdminBackupStorageLocation "my-storage" was rejected: request was rejected by the cluster administrator (reason: bucket does not exist)`,
+		},
+		{
+			name: "approved but never available",
+			transitions: []nacv1alpha1.NonAdminBackupStorageLocationStatus{
+				{
+					Phase:      nacv1alpha1.NonAdminPhaseCreated,
+					Conditions: []metav1.Condition{approved},
+					VeleroBackupStorageLocation: &nacv1alpha1.VeleroBackupStorageLocation{
+						Status: &velerov1.BackupStorageLocationStatus{Phase: velerov1.BackupStorageLocationPhaseUnavailable},
+					},
+				},
+			},
+			timeout:   200 * time.Millisecond,
+			expectErr: `timed out waiting for NonAdminBackupStorageLocation "my-storage" (last phase: Unavailable)`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nabsl := newNABSL("my-storage", "my-project", "aws", "my-bucket", "")
+			request := &nacv1alpha1.NonAdminBackupStorageLocationRequest{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "my-uuid",
+					Namespace:   "openshift-adp",
+					Annotations: map[string]string{shared.NABSLRejectionReasonAnnotation: "bucket does not exist"},
+				},
+			}
+			client := newFakeClient(t, nabsl, request)
+
+			go func() {
+				for _, status := range tt.transitions {
+					time.Sleep(20 * time.Millisecond)
+					nabsl.Status = status
+					if err := client.Update(context.Background(), nabsl); err != nil {
+						t.Errorf("Failed to update NABSL: %v", err)
+						return
+					}
+				}
+			}()
+
+			o := NewCreateOptions()
+			o.Namespace = "my-project"
+			o.client = client
+
+			ctx, cancel := context.WithTimeout(context.Background(), tt.timeout)
+			defer cancel()
+
+			var out bytes.Buffer
+			err := o.waitForNABSL(ctx, &out, "my-storage", nil)
+			if tt.expectErr != "" {
+				if err == nil || err.Error() != tt.expectErr {
+					t.Fatalf("waitForNABSL() error = %v, want %q", err, tt.expectErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("waitForNABSL() error = %v", err)
+			}
+			if !strings.Contains(out.String(), tt.expectOut) {
+				t.Errorf("expected output to contain %q, got:\n%s", tt.expectOut, out.String())
+			}
+		})
+	}
+}
+
+// TestCreateIfNotExists verifies --if-not-exists turns an AlreadyExists error into a notice
+func TestCreateIfNotExists(t *testing.T) {
+	for _, ifNotExists := range []bool{true, false} {
+		t.Run(fmt.Sprintf("if-not-exists=%t", ifNotExists), func(t *testing.T) {
+			o := NewCreateOptions()
+			c := &cobra.Command{}
+			o.BindFlags(c.Flags())
+			output.BindFlags(c.Flags())
+			output.ClearOutputFlagDefault(c)
+
+			o.Name = "my-storage"
+			o.Namespace = "my-project"
+			o.Provider = "aws"
+			o.Bucket = "my-bucket"
+			o.Region = "us-east-1"
+			o.IfNotExists = ifNotExists
+			o.client = newFakeClient(t, newNABSL("my-storage", "my-project", "aws", "other-bucket", ""))
+			var out bytes.Buffer
+			c.SetOut(&out)
+
+			err := o.Run(c, nil)
+			if !ifNotExists {
+				if !apierrors.IsAlreadyExists(err) {
+					t.Fatalf("Run() error = %v, want AlreadyExists", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Run() error = %v", err)
+			}
+			if want := `NonAdminBackupStorageLocation "my-storage" already exists, skipping creation.`; !strings.Contains(out.String(), want) {
+				t.Errorf("expected output to contain %q, got:\n%s", want, out.String())
+			}
+		})
+	}
+}
+
+// TestCreateOutput verifies the created notice and the nabsl-request hint are written to
+// the command's output
+func TestCreateOutput(t *testing.T) {
+	o := NewCreateOptions()
+	c := &cobra.Command{}
+	o.BindFlags(c.Flags())
+	output.BindFlags(c.Flags())
+	output.ClearOutputFlagDefault(c)
+
+	o.Name = "my-storage"
+	o.Namespace = "my-project"
+	o.Provider = "aws"
+	o.Bucket = "my-bucket"
+	o.Region = "us-east-1"
+	o.client = newFakeClient(t)
+	var out bytes.Buffer
+	c.SetOut(&out)
+
+	if err := o.Run(c, nil); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	for _, want := range []string{
+		`NonAdminBackupStorageLocation "my-storage" created successfully.`,
+		"Use 'kubectl oadp nabsl-request get' to view auto-created requests.",
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, out.String())
+		}
+	}
+}
+
+// TestCreateDryRunServer verifies --dry-run=server threads DryRunAll into Create and
+// prints the returned object in the -o format, yaml by default, without persisting it
+func TestCreateDryRunServer(t *testing.T) {
+	tests := []struct {
+		name   string
+		args   []string
+		expect []string
+	}{
+		{name: "yaml by default", expect: []string{"kind: NonAdminBackupStorageLocation", "bucket: my-bucket"}},
+		{name: "-o json", args: []string{"-o", "json"}, expect: []string{`"kind": "NonAdminBackupStorageLocation"`, `"bucket": "my-bucket"`}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := NewCreateOptions()
+			c := &cobra.Command{}
+			o.BindFlags(c.Flags())
+			output.BindFlags(c.Flags())
+			output.ClearOutputFlagDefault(c)
+			if err := c.Flags().Parse(tt.args); err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			o.Name = "my-storage"
+			o.Namespace = "my-project"
+			o.Provider = "aws"
+			o.Bucket = "my-bucket"
+			o.Region = "us-east-1"
+			o.DryRun = shared.DryRunServer
+
+			var createOpts kbclient.CreateOptions
+			o.client = interceptor.NewClient(newFakeClient(t), interceptor.Funcs{
+				Create: func(ctx context.Context, c kbclient.WithWatch, obj kbclient.Object, opts ...kbclient.CreateOption) error {
+					createOpts.ApplyOptions(opts)
+					return c.Create(ctx, obj, opts...)
+				},
+			})
+			var out bytes.Buffer
+			c.SetOut(&out)
+
+			if err := o.Run(c, nil); err != nil {
+				t.Fatalf("Run() error = %v", err)
+			}
+
+			if len(createOpts.DryRun) != 1 || createOpts.DryRun[0] != metav1.DryRunAll {
+				t.Errorf("expected Create to be called with DryRunAll, got %v", createOpts.DryRun)
+			}
+			for _, want := range tt.expect {
+				if !strings.Contains(out.String(), want) {
+					t.Errorf("expected output to contain %q, got:\n%s", want, out.String())
+				}
+			}
+
+			var nabsl nacv1alpha1.NonAdminBackupStorageLocation
+			err := o.client.Get(context.Background(), kbclient.ObjectKey{Namespace: "my-project", Name: "my-storage"}, &nabsl)
+			if !apierrors.IsNotFound(err) {
+				t.Errorf("expected the dry run not to persist the NABSL, Get() error = %v", err)
+			}
+		})
+	}
+}
+
+// TestCheckCredentialSecret verifies the --credential Secret and key must exist in the namespace
+func TestCheckCredentialSecret(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "cloud-credentials", Namespace: "my-project"},
+		Data:       map[string][]byte{"cloud": []byte("[default]")},
+	}
+
+	tests := []struct {
+		name        string
+		credential  string
+		expectError string
+	}{
+		{
+			name:       "secret and key present",
+			credential: "cloud-credentials=cloud",
+		},
+		{
+			name:        "secret missing",
+			credential:  "other-credentials=cloud",
+			expectError: `secret "other-credentials" not found in namespace "my-project"`,
+		},
+		{
+			name:        "key missing",
+			credential:  "cloud-credentials=azure",
+			expectError: `key "azure" not found in secret "cloud-credentials"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := NewCreateOptions()
+			o.Namespace = "my-project"
+			if err := o.Credential.Set(tt.credential); err != nil {
+				t.Fatalf("Credential.Set() error = %v", err)
+			}
+			o.client = newFakeClient(t, secret)
+
+			err := o.checkCredentialSecret(context.Background())
+			if tt.expectError == "" {
+				if err != nil {
+					t.Errorf("checkCredentialSecret() error = %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.expectError {
+				t.Errorf("checkCredentialSecret() error = %v, want %q", err, tt.expectError)
+			}
+		})
+	}
+}
+
+// TestCreateFromSecretFile verifies --from-secret-file creates the credential Secret and
+// wires it into the NABSL, refusing to overwrite an existing Secret without --force
+func TestCreateFromSecretFile(t *testing.T) {
+	credentials := filepath.Join(t.TempDir(), "credentials-velero")
+	if err := os.WriteFile(credentials, []byte("[default]\naws_access_key_id=new\n"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	existing := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "cloud-credentials", Namespace: "my-project"},
+		Data:       map[string][]byte{"cloud": []byte("[default]\naws_access_key_id=old\n")},
+	}
+
+	tests := []struct {
+		name        string
+		objs        []kbclient.Object
+		force       bool
+		expectError string
+	}{
+		{name: "new secret"},
+		{
+			name:        "existing secret",
+			objs:        []kbclient.Object{existing.DeepCopy()},
+			expectError: `secret "cloud-credentials" already exists in namespace "my-project"; use --force to overwrite it`,
+		},
+		{name: "existing secret with --force", objs: []kbclient.Object{existing.DeepCopy()}, force: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := NewCreateOptions()
+			c := &cobra.Command{}
+			o.BindFlags(c.Flags())
+			output.BindFlags(c.Flags())
+			output.ClearOutputFlagDefault(c)
+
+			o.Name = "my-storage"
+			o.Namespace = "my-project"
+			o.Provider = "aws"
+			o.Bucket = "my-bucket"
+			o.Region = "us-east-1"
+			o.FromSecretFile = credentials
+			o.SecretName = "cloud-credentials"
+			o.Force = tt.force
+			o.client = newFakeClient(t, tt.objs...)
+			c.SetOut(&bytes.Buffer{})
+
+			if err := o.readSecretFile(); err != nil {
+				t.Fatalf("readSecretFile() error = %v", err)
+			}
+			if err := o.validateSecretFile(); err != nil {
+				t.Fatalf("validateSecretFile() error = %v", err)
+			}
+			err := o.Run(c, nil)
+
+			var nabsl nacv1alpha1.NonAdminBackupStorageLocation
+			getErr := o.client.Get(context.Background(), kbclient.ObjectKey{Namespace: "my-project", Name: "my-storage"}, &nabsl)
+			if tt.expectError != "" {
+				if err == nil || err.Error() != tt.expectError {
+					t.Fatalf("Run() error = %v, want %q", err, tt.expectError)
+				}
+				if !apierrors.IsNotFound(getErr) {
+					t.Errorf("expected no NABSL to be created, Get() error = %v", getErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Run() error = %v", err)
+			}
+			if getErr != nil {
+				t.Fatalf("Get() NABSL error = %v", getErr)
+			}
+
+			credential := nabsl.Spec.BackupStorageLocationSpec.Credential
+			if credential == nil || credential.Name != "cloud-credentials" || credential.Key != "cloud" {
+				t.Errorf("expected the NABSL credential to be cloud-credentials/cloud, got %+v", credential)
+			}
+			var secret corev1.Secret
+			if err := o.client.Get(context.Background(), kbclient.ObjectKey{Namespace: "my-project", Name: "cloud-credentials"}, &secret); err != nil {
+				t.Fatalf("Get() Secret error = %v", err)
+			}
+			if got := string(secret.Data["cloud"]); !strings.Contains(got, "aws_access_key_id=new") {
+				t.Errorf("expected the Secret to hold the file's credentials, got %q", got)
+			}
+		})
+	}
+}
+
+// TestCreateFromSecretFileProtectsSecret verifies the Secret is only written once
+// the NABSL is known not to exist, and is deleted or restored if the NABSL create fails
+func TestCreateFromSecretFileProtectsSecret(t *testing.T) {
+	credentials := filepath.Join(t.TempDir(), "credentials-velero")
+	if err := os.WriteFile(credentials, []byte("[default]\naws_access_key_id=new\n"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	existing := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "cloud-credentials", Namespace: "my-project"},
+		Data:       map[string][]byte{"cloud": []byte("[default]\naws_access_key_id=old\n")},
+	}
+
+	tests := []struct {
+		name         string
+		objs         []kbclient.Object
+		ifNotExists  bool
+		failCreate   bool
+		expectError  string
+		expectSecret string
+	}{
+		{
+			name:         "existing NABSL with --if-not-exists keeps the live credential",
+			objs:         []kbclient.Object{newNABSL("my-storage", "my-project", "aws", "other-bucket", ""), existing.DeepCopy()},
+			ifNotExists:  true,
+			expectSecret: "aws_access_key_id=old",
+		},
+		{
+			name:         "existing NABSL without --if-not-exists",
+			objs:         []kbclient.Object{newNABSL("my-storage", "my-project", "aws", "other-bucket", ""), existing.DeepCopy()},
+			expectError:  `nonadminbackupstoragelocations.oadp.openshift.io "my-storage" already exists`,
+			expectSecret: "aws_access_key_id=old",
+		},
+		{
+			name:        "failed NABSL create removes the new secret",
+			failCreate:  true,
+			expectError: "create failed",
+		},
+		{
+			name:         "failed NABSL create restores the overwritten secret",
+			objs:         []kbclient.Object{existing.DeepCopy()},
+			failCreate:   true,
+			expectError:  "create failed",
+			expectSecret: "aws_access_key_id=old",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := NewCreateOptions()
+			c := &cobra.Command{}
+			o.BindFlags(c.Flags())
+			output.BindFlags(c.Flags())
+			output.ClearOutputFlagDefault(c)
+
+			o.Name = "my-storage"
+			o.Namespace = "my-project"
+			o.Provider = "aws"
+			o.Bucket = "my-bucket"
+			o.Region = "us-east-1"
+			o.FromSecretFile = credentials
+			o.SecretName = "cloud-credentials"
+			o.Force = true
+			o.IfNotExists = tt.ifNotExists
+			o.client = interceptor.NewClient(newFakeClient(t, tt.objs...), interceptor.Funcs{
+				Create: func(ctx context.Context, c kbclient.WithWatch, obj kbclient.Object, opts ...kbclient.CreateOption) error {
+					if _, ok := obj.(*nacv1alpha1.NonAdminBackupStorageLocation); ok && tt.failCreate {
+						return errors.New("create failed")
+					}
+					return c.Create(ctx, obj, opts...)
+				},
+			})
+			c.SetOut(&bytes.Buffer{})
+
+			if err := o.readSecretFile(); err != nil {
+				t.Fatalf("readSecretFile() error = %v", err)
+			}
+			err := o.Run(c, nil)
+			if tt.expectError == "" && err != nil {
+				t.Fatalf("Run() error = %v", err)
+			}
+			if tt.expectError != "" && (err == nil || err.Error() != tt.expectError) {
+				t.Fatalf("Run() error = %v, want %q", err, tt.expectError)
+			}
+
+			var secret corev1.Secret
+			getErr := o.client.Get(context.Background(), kbclient.ObjectKey{Namespace: "my-project", Name: "cloud-credentials"}, &secret)
+			if tt.expectSecret == "" {
+				if !apierrors.IsNotFound(getErr) {
+					t.Errorf("expected the secret to be deleted, Get() error = %v", getErr)
+				}
+				return
+			}
+			if getErr != nil {
+				t.Fatalf("Get() Secret error = %v", getErr)
+			}
+			if got := string(secret.Data["cloud"]); !strings.Contains(got, tt.expectSecret) {
+				t.Errorf("expected the Secret to still hold %q, got %q", tt.expectSecret, got)
+			}
+		})
+	}
+}
+
+// TestValidateSecretFileFlags verifies the flag combinations --from-secret-file accepts
+func TestValidateSecretFileFlags(t *testing.T) {
+	credentials := filepath.Join(t.TempDir(), "credentials-velero")
+	if err := os.WriteFile(credentials, []byte("[default]\n"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		args        []string
+		expectError string
+	}{
+		{name: "file and secret name", args: []string{"--from-secret-file", credentials, "--secret-name", "creds"}},
+		{name: "no secret name", args: []string{"--from-secret-file", credentials}, expectError: "--from-secret-file requires --secret-name"},
+		{name: "secret name alone", args: []string{"--secret-name", "creds"}, expectError: "--secret-name can only be used with --from-secret-file"},
+		{name: "force alone", args: []string{"--force"}, expectError: "--force can only be used with --from-secret-file"},
+		{
+			name:        "with --credential",
+			args:        []string{"--from-secret-file", credentials, "--secret-name", "creds", "--credential", "other=cloud"},
+			expectError: "--credential cannot be used with --from-secret-file",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := NewCreateOptions()
+			c := &cobra.Command{}
+			o.BindFlags(c.Flags())
+			if err := c.Flags().Parse(tt.args); err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if err := o.readSecretFile(); err != nil {
+				t.Fatalf("readSecretFile() error = %v", err)
+			}
+			err := o.validateSecretFile()
+			if tt.expectError == "" {
+				if err != nil {
+					t.Errorf("validateSecretFile() error = %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.expectError {
+				t.Errorf("validateSecretFile() error = %v, want %q", err, tt.expectError)
+			}
+		})
+	}
+}
@@ -0,0 +1,245 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nonadmin
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+	appsv1 "k8s.io/api/apps/v1"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/migtools/oadp-cli/cmd/shared"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+)
+
+// NewDoctorCommand creates the "doctor" subcommand under nonadmin
+func NewDoctorCommand(f client.Factory) *cobra.Command {
+	o := NewDoctorOptions()
+
+	c := &cobra.Command{
+		Use:   "doctor",
+		Short: "Check that non-admin backups can work in the current namespace",
+		Long: `Check that non-admin backups can work in the current namespace.
+
+Verifies that the OADP non-admin controller (NAC) CRDs are installed, that the OADP
+operator is ready, that the current namespace can hold non-admin resources, and that
+you are allowed to create them. Checks that can't be performed with your permissions
+are reported as unknown.`,
+		Args: cobra.NoArgs,
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args, f))
+			cmd.CheckError(o.Run(c))
+		},
+		Example: `  # Diagnose why non-admin backups don't work
+  kubectl oadp nonadmin doctor`,
+	}
+
+	return c
+}
+
+// DoctorOptions holds the options for the doctor command
+type DoctorOptions struct {
+	Namespace string
+	client    kbclient.Client
+}
+
+// NewDoctorOptions creates a new DoctorOptions instance
+func NewDoctorOptions() *DoctorOptions {
+	return &DoctorOptions{}
+}
+
+// Complete resolves the current namespace and creates the client
+func (o *DoctorOptions) Complete(args []string, f client.Factory) error {
+	kbClient, err := shared.NewClientWithScheme(f, shared.ClientOptions{
+		IncludeNonAdminTypes: true,
+	})
+	if err != nil {
+		return err
+	}
+	o.client = kbClient
+
+	currentNS, err := shared.GetCurrentNamespace()
+	if err != nil {
+		return fmt.Errorf("failed to determine current namespace: %w", err)
+	}
+	o.Namespace = currentNS
+
+	return nil
+}
+
+// Run prints the checklist, failing if any check failed
+func (o *DoctorOptions) Run(c *cobra.Command) error {
+	ctx, cancel := shared.RequestContext()
+	defer cancel()
+
+	return runDoctor(ctx, c.OutOrStdout(), o.client, o.Namespace)
+}
+
+// checkResult is the outcome of a single doctor check
+type checkResult int
+
+const (
+	checkPassed checkResult = iota
+	checkFailed
+	checkUnknown
+)
+
+// doctorCheck is a line of the doctor checklist; hint explains how to fix a failure
+type doctorCheck struct {
+	name   string
+	result checkResult
+	detail string
+	hint   string
+}
+
+// runDoctor runs every check and prints the checklist, returning an error if any failed
+func runDoctor(ctx context.Context, w io.Writer, kbClient kbclient.Client, namespace string) error {
+	// Unknown OADP namespace only weakens the namespace check, so detection errors are reported by checkOperatorReady
+	oadpNamespace, detectErr := shared.DetectOADPNamespace(ctx, kbClient)
+
+	checks := []doctorCheck{
+		checkNonAdminCRDs(kbClient),
+		checkOperatorReady(ctx, kbClient, oadpNamespace, detectErr),
+		checkNamespace(namespace, oadpNamespace),
+	}
+	checks = append(checks, checkCreatePermissions(ctx, kbClient, namespace)...)
+
+	symbols := shared.Symbols(w)
+	failed := 0
+	for _, check := range checks {
+		switch check.result {
+		case checkPassed:
+			fmt.Fprintf(w, "%s %s", symbols.OK, check.name)
+		case checkFailed:
+			failed++
+			fmt.Fprintf(w, "%s %s", symbols.Fail, check.name)
+		default:
+			fmt.Fprintf(w, "%s %s", symbols.Unknown, check.name)
+		}
+		if check.detail != "" {
+			fmt.Fprintf(w, ": %s", check.detail)
+		}
+		fmt.Fprintln(w)
+		if check.result != checkPassed && check.hint != "" {
+			fmt.Fprintf(w, "    %s\n", check.hint)
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d check(s) failed", failed)
+	}
+	return nil
+}
+
+// checkNonAdminCRDs checks that the API server serves the NAC kinds
+func checkNonAdminCRDs(kbClient kbclient.Client) doctorCheck {
+	check := doctorCheck{
+		name: "NonAdmin CRDs installed",
+		hint: "Ask your cluster admin to enable non-admin backups (spec.nonAdmin.enable in the DataProtectionApplication).",
+	}
+
+	missing, err := shared.MissingNonAdminKinds(kbClient.RESTMapper(), shared.NonAdminKinds...)
+	switch {
+	case err != nil:
+		check.result = checkUnknown
+		check.detail = err.Error()
+	case len(missing) > 0:
+		check.result = checkFailed
+		check.detail = "missing " + strings.Join(missing, ", ")
+	}
+	return check
+}
+
+// checkOperatorReady checks that the OADP operator deployment in the detected OADP
+// namespace has all its replicas ready; detectErr is the error detecting the namespace
+func checkOperatorReady(ctx context.Context, kbClient kbclient.Client, namespace string, detectErr error) doctorCheck {
+	check := doctorCheck{
+		name: "OADP operator ready",
+		hint: "Ask your cluster admin to check the OADP operator installation.",
+	}
+
+	if detectErr != nil {
+		// Non-admin users usually can't list deployments
+		check.result = checkUnknown
+		check.detail = detectErr.Error()
+		return check
+	}
+
+	var deployment appsv1.Deployment
+	if err := kbClient.Get(ctx, kbclient.ObjectKey{Namespace: namespace, Name: shared.OADPOperatorDeployment}, &deployment); err != nil {
+		check.result = checkUnknown
+		check.detail = err.Error()
+		return check
+	}
+
+	desired := int32(1)
+	if deployment.Spec.Replicas != nil {
+		desired = *deployment.Spec.Replicas
+	}
+	check.detail = fmt.Sprintf("%s/%s has %d/%d replicas ready", namespace, deployment.Name, deployment.Status.ReadyReplicas, desired)
+	if desired == 0 || deployment.Status.ReadyReplicas < desired {
+		check.result = checkFailed
+	}
+	return check
+}
+
+// checkNamespace checks that the current namespace is one non-admin resources can live in
+func checkNamespace(namespace, oadpNamespace string) doctorCheck {
+	check := doctorCheck{
+		name:   "Namespace usable for non-admin resources",
+		detail: namespace,
+		hint:   "Switch to your own project, e.g. `oc project my-project`.",
+	}
+
+	switch {
+	case namespace == "":
+		check.result = checkFailed
+		check.detail = "no namespace set in the current context"
+	case namespace == oadpNamespace:
+		// NAC ignores non-admin resources in the OADP namespace
+		check.result = checkFailed
+		check.detail = namespace + " is the OADP namespace"
+	}
+	return check
+}
+
+// checkCreatePermissions checks that the user can create each non-admin resource
+func checkCreatePermissions(ctx context.Context, kbClient kbclient.Client, namespace string) []doctorCheck {
+	checks := make([]doctorCheck, 0, len(whoAmIResources))
+	for _, resource := range whoAmIResources {
+		check := doctorCheck{
+			name: "Can create " + resource,
+			hint: fmt.Sprintf("Ask your cluster admin for permission to create %s in %s.", resource, namespace),
+		}
+
+		allowed, err := canCreate(ctx, kbClient, namespace, resource)
+		switch {
+		case err != nil:
+			check.result = checkUnknown
+			check.detail = err.Error()
+		case !allowed:
+			check.result = checkFailed
+		}
+		checks = append(checks, check)
+	}
+	return checks
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nonadmin
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/migtools/oadp-cli/cmd/shared"
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+)
+
+// TestRunDoctor verifies the checklist for a healthy cluster, missing CRDs and a
+// not-ready operator
+func TestRunDoctor(t *testing.T) {
+	operator := func(ready int32) *appsv1.Deployment {
+		replicas := int32(1)
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: shared.OADPOperatorDeployment, Namespace: "openshift-adp"},
+			Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+			Status:     appsv1.DeploymentStatus{ReadyReplicas: ready},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		servedKinds  []string
+		operator     *appsv1.Deployment
+		namespace    string
+		expectError  bool
+		expectOutput []string
+	}{
+		{
+			name:        "healthy",
+			servedKinds: shared.NonAdminKinds,
+			operator:    operator(1),
+			namespace:   "my-project",
+			expectOutput: []string{
+				"[OK] NonAdmin CRDs installed",
+				"[OK] OADP operator ready: openshift-adp/openshift-adp-controller-manager has 1/1 replicas ready",
+				"[OK] Namespace usable for non-admin resources: my-project",
+				"[OK] Can create nonadminbackups",
+			},
+		},
+		{
+			name:        "missing CRDs",
+			servedKinds: []string{"NonAdminBackupStorageLocation"},
+			operator:    operator(1),
+			namespace:   "my-project",
+			expectError: true,
+			expectOutput: []string{
+				"[FAIL] NonAdmin CRDs installed: missing NonAdminBackup, NonAdminRestore",
+				"Ask your cluster admin to enable non-admin backups",
+			},
+		},
+		{
+			name:        "operator not ready",
+			servedKinds: shared.NonAdminKinds,
+			operator:    operator(0),
+			namespace:   "my-project",
+			expectError: true,
+			expectOutput: []string{
+				"[FAIL] OADP operator ready: openshift-adp/openshift-adp-controller-manager has 0/1 replicas ready",
+				"Ask your cluster admin to check the OADP operator installation.",
+			},
+		},
+		{
+			name:        "operator not readable",
+			servedKinds: shared.NonAdminKinds,
+			namespace:   "my-project",
+			expectOutput: []string{
+				"[UNKNOWN] OADP operator ready",
+				"[OK] Namespace usable for non-admin resources: my-project",
+			},
+		},
+		{
+			name:        "OADP namespace",
+			servedKinds: shared.NonAdminKinds,
+			operator:    operator(1),
+			namespace:   "openshift-adp",
+			expectError: true,
+			expectOutput: []string{
+				"[FAIL] Namespace usable for non-admin resources: openshift-adp is the OADP namespace",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheme, err := shared.NewSchemeWithTypes(shared.ClientOptions{IncludeNonAdminTypes: true})
+			if err != nil {
+				t.Fatalf("Failed to build scheme: %v", err)
+			}
+			if err := appsv1.AddToScheme(scheme); err != nil {
+				t.Fatalf("Failed to add apps types: %v", err)
+			}
+			if err := authorizationv1.AddToScheme(scheme); err != nil {
+				t.Fatalf("Failed to add authorization types: %v", err)
+			}
+
+			// Only the served kinds are known to the REST mapper, as with discovery
+			mapper := meta.NewDefaultRESTMapper(nil)
+			for _, kind := range tt.servedKinds {
+				mapper.Add(nacv1alpha1.GroupVersion.WithKind(kind), meta.RESTScopeNamespace)
+			}
+			mapper.Add(appsv1.SchemeGroupVersion.WithKind("Deployment"), meta.RESTScopeNamespace)
+
+			builder := fake.NewClientBuilder().WithScheme(scheme).WithRESTMapper(mapper)
+			if tt.operator != nil {
+				builder = builder.WithObjects(tt.operator)
+			}
+			client := builder.WithInterceptorFuncs(interceptor.Funcs{
+				Create: func(ctx context.Context, c kbclient.WithWatch, obj kbclient.Object, opts ...kbclient.CreateOption) error {
+					if review, ok := obj.(*authorizationv1.SelfSubjectAccessReview); ok {
+						review.Status.Allowed = true
+						return nil
+					}
+					return c.Create(ctx, obj, opts...)
+				},
+			}).Build()
+
+			var out bytes.Buffer
+			err = runDoctor(context.Background(), &out, client, tt.namespace)
+			if tt.expectError != (err != nil) {
+				t.Errorf("runDoctor() error = %v, expectError %v", err, tt.expectError)
+			}
+			for _, want := range tt.expectOutput {
+				if !strings.Contains(out.String(), want) {
+					t.Errorf("expected output to contain %q, got:\n%s", want, out.String())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package downloadrequest
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/migtools/oadp-cli/cmd/shared"
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+)
+
+func NewDeleteCommand(f client.Factory) *cobra.Command {
+	o := NewDeleteOptions()
+
+	c := &cobra.Command{
+		Use:   "delete [NAME...]",
+		Short: "Delete non-admin download requests",
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args, f))
+			cmd.CheckError(o.Validate())
+			cmd.CheckError(o.Run(c))
+		},
+		Example: `  # Delete a download request
+  kubectl oadp nonadmin downloadrequest delete my-backup-backuplog-x7k2p
+
+  # Delete every download request in the current namespace, e.g. ones left behind by an interrupted logs command
+  kubectl oadp nonadmin downloadrequest delete --all
+
+  # Delete every download request without the confirmation prompt
+  kubectl oadp nonadmin downloadrequest delete --all --confirm`,
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+type DeleteOptions struct {
+	Names     []string
+	Namespace string
+	All       bool
+	Confirm   bool // Skip the --all confirmation prompt
+	client    kbclient.Client
+}
+
+func NewDeleteOptions() *DeleteOptions {
+	return &DeleteOptions{}
+}
+
+func (o *DeleteOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.BoolVar(&o.All, "all", false, "Delete all download requests in the current namespace")
+	shared.BindConfirmFlags(flags, &o.Confirm)
+}
+
+func (o *DeleteOptions) Complete(args []string, f client.Factory) error {
+	o.Names = args
+
+	kbClient, err := shared.NewClientWithScheme(f, shared.ClientOptions{
+		IncludeNonAdminTypes: true,
+	})
+	if err != nil {
+		return err
+	}
+	o.client = kbClient
+
+	currentNS, err := shared.GetCurrentNamespace()
+	if err != nil {
+		return fmt.Errorf("failed to determine current namespace: %w", err)
+	}
+	o.Namespace = currentNS
+
+	return nil
+}
+
+func (o *DeleteOptions) Validate() error {
+	if o.All && len(o.Names) > 0 {
+		return fmt.Errorf("download request names cannot be used with --all")
+	}
+	if !o.All && len(o.Names) == 0 {
+		return fmt.Errorf("at least one download request name is required, or use --all")
+	}
+	return nil
+}
+
+func (o *DeleteOptions) Run(c *cobra.Command) error {
+	names := o.Names
+	if o.All {
+		var err error
+		names, err = o.listRequests()
+		if err != nil {
+			return err
+		}
+		if len(names) == 0 {
+			fmt.Fprintln(c.OutOrStdout(), "No non-admin download requests found.")
+			return nil
+		}
+
+		// --all also catches requests a running logs or describe command is still using
+		if !o.Confirm {
+			w := c.OutOrStdout()
+			fmt.Fprintf(w, "The following NonAdminDownloadRequest(s) will be deleted in namespace '%s', including any in use by a running logs or describe command:\n", o.Namespace)
+			for _, name := range names {
+				fmt.Fprintf(w, "  - %s\n", name)
+			}
+			fmt.Fprintln(w)
+
+			confirmed, err := shared.Confirm(c.InOrStdin(), w, fmt.Sprintf("Are you sure you want to delete these %d download requests?", len(names)))
+			if err != nil {
+				return err
+			}
+			if !confirmed {
+				fmt.Fprintln(w, "Deletion cancelled.")
+				return nil
+			}
+		}
+	}
+
+	// Start the request timeout only after any confirmation prompt has been answered
+	ctx, cancel := shared.RequestContext()
+	defer cancel()
+
+	return o.deleteRequests(ctx, c.OutOrStdout(), names)
+}
+
+// listRequests returns the names of every download request in the namespace, for --all
+func (o *DeleteOptions) listRequests() ([]string, error) {
+	ctx, cancel := shared.RequestContext()
+	defer cancel()
+
+	var list nacv1alpha1.NonAdminDownloadRequestList
+	if err := o.client.List(ctx, &list, kbclient.InNamespace(o.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list NonAdminDownloadRequests: %w", err)
+	}
+
+	names := make([]string, 0, len(list.Items))
+	for _, request := range list.Items {
+		names = append(names, request.Name)
+	}
+	return names, nil
+}
+
+// deleteRequests deletes the named download requests, reporting each one, and returns an
+// error if any could not be deleted
+func (o *DeleteOptions) deleteRequests(ctx context.Context, w io.Writer, names []string) error {
+	symbols := shared.Symbols(w)
+	failed := 0
+	for _, name := range names {
+		request := &nacv1alpha1.NonAdminDownloadRequest{}
+		request.Name = name
+		request.Namespace = o.Namespace
+		if err := o.client.Delete(ctx, request); err != nil {
+			fmt.Fprintf(w, "%s Failed to delete %s: %v\n", symbols.Fail, name, shared.TranslateError("download request", name, err))
+			failed++
+			continue
+		}
+		fmt.Fprintf(w, "%s %s deleted\n", symbols.OK, name)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to delete %d download request(s)", failed)
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package downloadrequest
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/migtools/oadp-cli/cmd/shared"
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+)
+
+// newFakeClient returns a fake client seeded with the given objects
+func newFakeClient(t *testing.T, objs ...kbclient.Object) kbclient.WithWatch {
+	t.Helper()
+
+	scheme, err := shared.NewSchemeWithTypes(shared.ClientOptions{IncludeNonAdminTypes: true})
+	if err != nil {
+		t.Fatalf("Failed to build scheme: %v", err)
+	}
+
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+// TestDeleteAll verifies --all prompts before deleting every download request, unless
+// the prompt is skipped with --confirm
+func TestDeleteAll(t *testing.T) {
+	tests := []struct {
+		name          string
+		confirm       bool
+		input         string
+		expectDeleted bool
+		expectOut     string
+	}{
+		{name: "confirmed", input: "y\n", expectDeleted: true, expectOut: "Are you sure you want to delete these 2 download requests? (y/N)"},
+		{name: "declined", input: "n\n", expectOut: "Deletion cancelled."},
+		{name: "input closed", input: "", expectOut: "Deletion cancelled."},
+		{name: "--confirm skips the prompt", confirm: true, expectDeleted: true, expectOut: "[OK] my-backup-backuplog-x7k2p deleted"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newFakeClient(t,
+				&nacv1alpha1.NonAdminDownloadRequest{ObjectMeta: metav1.ObjectMeta{Name: "my-backup-backuplog-x7k2p", Namespace: "my-project"}},
+				&nacv1alpha1.NonAdminDownloadRequest{ObjectMeta: metav1.ObjectMeta{Name: "my-backup-backupresults-q9r4t", Namespace: "my-project"}},
+			)
+
+			o := NewDeleteOptions()
+			o.Namespace = "my-project"
+			o.All = true
+			o.Confirm = tt.confirm
+			o.client = client
+
+			var out bytes.Buffer
+			c := &cobra.Command{}
+			c.SetOut(&out)
+			c.SetIn(strings.NewReader(tt.input))
+			if err := o.Run(c); err != nil {
+				t.Fatalf("Run() error = %v", err)
+			}
+
+			if !strings.Contains(out.String(), tt.expectOut) {
+				t.Errorf("expected output to contain %q, got:\n%s", tt.expectOut, out.String())
+			}
+			if strings.Contains(out.String(), "(y/N)") == tt.confirm {
+				t.Errorf("expected the prompt to be shown only without --confirm, got:\n%s", out.String())
+			}
+
+			var list nacv1alpha1.NonAdminDownloadRequestList
+			if err := client.List(context.Background(), &list, kbclient.InNamespace("my-project")); err != nil {
+				t.Fatalf("List() error = %v", err)
+			}
+			if deleted := len(list.Items) == 0; deleted != tt.expectDeleted {
+				t.Errorf("expected requests deleted = %v, %d remain", tt.expectDeleted, len(list.Items))
+			}
+		})
+	}
+}
+
+// slowReader answers a prompt only after delay, like a user taking their time
+type slowReader struct {
+	delay  time.Duration
+	answer io.Reader
+}
+
+func (r *slowReader) Read(p []byte) (int, error) {
+	time.Sleep(r.delay)
+	return r.answer.Read(p)
+}
+
+// TestDeleteAllSlowConfirmation verifies the request timeout starts after the --all
+// prompt is answered, so a slow answer doesn't make every delete time out
+func TestDeleteAllSlowConfirmation(t *testing.T) {
+	shared.RequestTimeout = 20 * time.Millisecond
+	t.Cleanup(func() { shared.RequestTimeout = shared.DefaultRequestTimeout })
+
+	// The fake client ignores the context, so fail calls made after it expired like a real one
+	client := interceptor.NewClient(newFakeClient(t,
+		&nacv1alpha1.NonAdminDownloadRequest{ObjectMeta: metav1.ObjectMeta{Name: "my-backup-backuplog-x7k2p", Namespace: "my-project"}},
+	), interceptor.Funcs{
+		Delete: func(ctx context.Context, c kbclient.WithWatch, obj kbclient.Object, opts ...kbclient.DeleteOption) error {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			return c.Delete(ctx, obj, opts...)
+		},
+	})
+
+	o := NewDeleteOptions()
+	o.Namespace = "my-project"
+	o.All = true
+	o.client = client
+
+	var out bytes.Buffer
+	c := &cobra.Command{}
+	c.SetOut(&out)
+	c.SetIn(&slowReader{delay: 100 * time.Millisecond, answer: strings.NewReader("y\n")})
+	if err := o.Run(c); err != nil {
+		t.Fatalf("Run() error = %v\n%s", err, out.String())
+	}
+
+	if want := "[OK] my-backup-backuplog-x7k2p deleted"; !strings.Contains(out.String(), want) {
+		t.Errorf("expected output to contain %q, got:\n%s", want, out.String())
+	}
+}
+
+// TestDeleteNamed verifies named requests are deleted without a prompt, reporting the
+// ones that could not be found
+func TestDeleteNamed(t *testing.T) {
+	client := newFakeClient(t,
+		&nacv1alpha1.NonAdminDownloadRequest{ObjectMeta: metav1.ObjectMeta{Name: "my-backup-backuplog-x7k2p", Namespace: "my-project"}},
+	)
+
+	o := NewDeleteOptions()
+	o.Namespace = "my-project"
+	o.Names = []string{"my-backup-backuplog-x7k2p", "missing"}
+	o.client = client
+
+	var out bytes.Buffer
+	c := &cobra.Command{}
+	c.SetOut(&out)
+	if err := o.Run(c); err == nil || err.Error() != "failed to delete 1 download request(s)" {
+		t.Fatalf("Run() error = %v, want one failed delete", err)
+	}
+
+	for _, want := range []string{"[OK] my-backup-backuplog-x7k2p deleted", "[FAIL] Failed to delete missing"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, out.String())
+		}
+	}
+}
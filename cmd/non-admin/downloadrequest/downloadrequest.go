@@ -0,0 +1,46 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package downloadrequest
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/migtools/oadp-cli/cmd/shared"
+	"github.com/vmware-tanzu/velero/pkg/client"
+)
+
+// NewDownloadRequestCommand creates the "downloadrequest" subcommand under nonadmin
+func NewDownloadRequestCommand(f client.Factory) *cobra.Command {
+	c := &cobra.Command{
+		Use:     "downloadrequest",
+		Aliases: []string{"nadr"},
+		Short:   "Work with non-admin download requests",
+		Long: `Work with non-admin download requests
+
+The logs and describe commands create a NonAdminDownloadRequest to fetch backup data and
+delete it when they are done. Use these commands to find and clean up requests left
+behind, e.g. when a command was killed.`,
+		PersistentPreRun: shared.NonAdminPreRun(f),
+	}
+
+	c.AddCommand(
+		NewGetCommand(f),
+		NewDeleteCommand(f),
+	)
+
+	return c
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package downloadrequest
+
+import (
+	"testing"
+
+	"github.com/migtools/oadp-cli/internal/testutil"
+)
+
+// TestDownloadRequestHelp tests the help output of the downloadrequest commands
+func TestDownloadRequestHelp(t *testing.T) {
+	binaryPath := testutil.BuildCLIBinary(t)
+
+	tests := []struct {
+		name           string
+		args           []string
+		expectContains []string
+	}{
+		{
+			name: "nonadmin downloadrequest help",
+			args: []string{"nonadmin", "downloadrequest", "--help"},
+			expectContains: []string{
+				"Work with non-admin download requests",
+				"get",
+				"delete",
+			},
+		},
+		{
+			name: "nonadmin nadr alias",
+			args: []string{"nonadmin", "nadr", "--help"},
+			expectContains: []string{
+				"Work with non-admin download requests",
+			},
+		},
+		{
+			name: "nonadmin downloadrequest get help",
+			args: []string{"nonadmin", "downloadrequest", "get", "--help"},
+			expectContains: []string{
+				"Get non-admin download requests",
+				"--no-headers",
+			},
+		},
+		{
+			name: "nonadmin downloadrequest delete help",
+			args: []string{"nonadmin", "downloadrequest", "delete", "--help"},
+			expectContains: []string{
+				"Delete non-admin download requests",
+				"--all",
+				"--assume-yes",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testutil.TestHelpCommand(t, binaryPath, tt.args, tt.expectContains)
+		})
+	}
+}
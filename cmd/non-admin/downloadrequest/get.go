@@ -0,0 +1,165 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package downloadrequest
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/migtools/oadp-cli/cmd/shared"
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
+)
+
+func NewGetCommand(f client.Factory) *cobra.Command {
+	o := NewGetOptions()
+
+	c := &cobra.Command{
+		Use:   "get [NAME]",
+		Short: "Get non-admin download requests",
+		Args:  cobra.MaximumNArgs(1),
+		// Velero's table printer doesn't know the NAC types, so only json and yaml work
+		Annotations: map[string]string{shared.OutputFormatsAnnotation: "json,yaml"},
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args, f))
+			cmd.CheckError(o.Run(c))
+		},
+		Example: `  # List the download requests in the current namespace
+  kubectl oadp nonadmin downloadrequest get
+
+  # Get a specific download request as YAML
+  kubectl oadp nonadmin downloadrequest get my-backup-backuplog-x7k2p -o yaml`,
+	}
+
+	o.BindFlags(c.Flags())
+	output.BindFlags(c.Flags())
+	output.ClearOutputFlagDefault(c)
+
+	return c
+}
+
+type GetOptions struct {
+	Name      string
+	Namespace string
+	NoHeaders bool
+	client    kbclient.Client
+}
+
+func NewGetOptions() *GetOptions {
+	return &GetOptions{}
+}
+
+func (o *GetOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.BoolVar(&o.NoHeaders, "no-headers", false, "When using the default output format, don't print headers")
+}
+
+func (o *GetOptions) Complete(args []string, f client.Factory) error {
+	if len(args) > 0 {
+		o.Name = args[0]
+	}
+
+	kbClient, err := shared.NewClientWithScheme(f, shared.ClientOptions{
+		IncludeNonAdminTypes: true,
+	})
+	if err != nil {
+		return err
+	}
+	o.client = kbClient
+
+	currentNS, err := shared.GetCurrentNamespace()
+	if err != nil {
+		return fmt.Errorf("failed to determine current namespace: %w", err)
+	}
+	o.Namespace = currentNS
+
+	return nil
+}
+
+func (o *GetOptions) Run(c *cobra.Command) error {
+	ctx, cancel := shared.RequestContext()
+	defer cancel()
+
+	if o.Name != "" {
+		var request nacv1alpha1.NonAdminDownloadRequest
+		if err := o.client.Get(ctx, kbclient.ObjectKey{Namespace: o.Namespace, Name: o.Name}, &request); err != nil {
+			return shared.TranslateError("download request", o.Name, err)
+		}
+
+		if printed, err := output.PrintWithFormat(c, &request); printed || err != nil {
+			return err
+		}
+
+		list := &nacv1alpha1.NonAdminDownloadRequestList{
+			Items: []nacv1alpha1.NonAdminDownloadRequest{request},
+		}
+		return printDownloadRequestTable(c.OutOrStdout(), list, o.NoHeaders)
+	}
+
+	var list nacv1alpha1.NonAdminDownloadRequestList
+	if err := o.client.List(ctx, &list, kbclient.InNamespace(o.Namespace)); err != nil {
+		return fmt.Errorf("failed to list NonAdminDownloadRequests: %w", err)
+	}
+	sort.Slice(list.Items, func(i, j int) bool {
+		return list.Items[i].Name < list.Items[j].Name
+	})
+
+	if printed, err := output.PrintWithFormat(c, &list); printed || err != nil {
+		return err
+	}
+
+	return printDownloadRequestTable(c.OutOrStdout(), &list, o.NoHeaders)
+}
+
+// printDownloadRequestTable prints the download requests with what each one downloads
+func printDownloadRequestTable(out io.Writer, list *nacv1alpha1.NonAdminDownloadRequestList, noHeaders bool) error {
+	if len(list.Items) == 0 {
+		fmt.Fprintln(out, "No non-admin download requests found.")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	defer w.Flush()
+
+	if !noHeaders {
+		fmt.Fprintln(w, "NAME\tPHASE\tTARGET-KIND\tTARGET-NAME\tAGE")
+	}
+
+	for _, request := range list.Items {
+		phase := string(request.Status.Phase)
+		if phase == "" {
+			phase = "Unknown"
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+			request.Name,
+			phase,
+			request.Spec.Target.Kind,
+			request.Spec.Target.Name,
+			shared.FormatAge(request.CreationTimestamp.Time),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package downloadrequest
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+)
+
+// TestPrintDownloadRequestTable verifies the table shows each request's phase and target
+func TestPrintDownloadRequestTable(t *testing.T) {
+	list := &nacv1alpha1.NonAdminDownloadRequestList{
+		Items: []nacv1alpha1.NonAdminDownloadRequest{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-backup-backuplog-x7k2p", Namespace: "my-project", CreationTimestamp: metav1.Now()},
+				Spec:       nacv1alpha1.NonAdminDownloadRequestSpec{Target: velerov1.DownloadTarget{Kind: velerov1.DownloadTargetKindBackupLog, Name: "my-backup"}},
+				Status:     nacv1alpha1.NonAdminDownloadRequestStatus{Phase: nacv1alpha1.NonAdminPhaseCreated},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-backup-backupresults-q9r4t", Namespace: "my-project", CreationTimestamp: metav1.Now()},
+				Spec:       nacv1alpha1.NonAdminDownloadRequestSpec{Target: velerov1.DownloadTarget{Kind: velerov1.DownloadTargetKindBackupResults, Name: "my-backup"}},
+			},
+		},
+	}
+
+	var out bytes.Buffer
+	if err := printDownloadRequestTable(&out, list, false); err != nil {
+		t.Fatalf("printDownloadRequestTable() error = %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	expected := [][]string{
+		{"NAME", "PHASE", "TARGET-KIND", "TARGET-NAME", "AGE"},
+		{"my-backup-backuplog-x7k2p", "Created", "BackupLog", "my-backup"},
+		{"my-backup-backupresults-q9r4t", "Unknown", "BackupResults", "my-backup"},
+	}
+	if len(lines) != len(expected) {
+		t.Fatalf("expected %d lines, got %d:\n%s", len(expected), len(lines), out.String())
+	}
+	for i, want := range expected {
+		fields := strings.Fields(lines[i])
+		for j, field := range want {
+			if j >= len(fields) || fields[j] != field {
+				t.Errorf("line %d: expected %v, got %q", i, want, lines[i])
+				break
+			}
+		}
+	}
+
+	out.Reset()
+	if err := printDownloadRequestTable(&out, &nacv1alpha1.NonAdminDownloadRequestList{}, false); err != nil {
+		t.Fatalf("printDownloadRequestTable() error = %v", err)
+	}
+	if want := "No non-admin download requests found."; strings.TrimSpace(out.String()) != want {
+		t.Errorf("expected %q for an empty list, got %q", want, out.String())
+	}
+}
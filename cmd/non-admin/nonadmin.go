@@ -19,6 +19,8 @@ package nonadmin
 import (
 	"github.com/migtools/oadp-cli/cmd/non-admin/backup"
 	"github.com/migtools/oadp-cli/cmd/non-admin/bsl"
+	"github.com/migtools/oadp-cli/cmd/non-admin/downloadrequest"
+	"github.com/migtools/oadp-cli/cmd/shared"
 	"github.com/spf13/cobra"
 	"github.com/vmware-tanzu/velero/pkg/client"
 )
@@ -29,14 +31,26 @@ func NewNonAdminCommand(f client.Factory) *cobra.Command {
 		Use:     "nonadmin",
 		Short:   "Work with non-admin resources",
 		Long:    "Work with non-admin resources like backups and backup storage locations",
-		Aliases: []string{"na"},
+		Aliases: []string{"na", "non-admin", "nad"},
 	}
 
+	shared.BindRequestTimeoutFlag(c.PersistentFlags())
+	shared.BindImpersonationFlags(c.PersistentFlags())
+
 	// Add backup subcommand
 	c.AddCommand(backup.NewBackupCommand(f))
 
 	// Add backup storage location subcommand
 	c.AddCommand(bsl.NewBSLCommand(f))
 
+	// Add download request cleanup subcommand
+	c.AddCommand(downloadrequest.NewDownloadRequestCommand(f))
+
+	// Add whoami diagnostics subcommand
+	c.AddCommand(NewWhoAmICommand(f))
+
+	// Add doctor diagnostics subcommand
+	c.AddCommand(NewDoctorCommand(f))
+
 	return c
 }
@@ -39,6 +39,9 @@ func TestNonAdminCommands(t *testing.T) {
 				"Work with non-admin resources like backups",
 				"backup",
 				"bsl",
+				"downloadrequest",
+				"whoami",
+				"doctor",
 			},
 		},
 		{
@@ -65,6 +68,23 @@ func TestNonAdminCommands(t *testing.T) {
 	}
 }
 
+// TestNonAdminAliases tests that every alias of the nonadmin command resolves to it
+func TestNonAdminAliases(t *testing.T) {
+	binaryPath := testutil.BuildCLIBinary(t)
+
+	for _, alias := range []string{"na", "non-admin", "nad"} {
+		t.Run(alias, func(t *testing.T) {
+			testutil.TestHelpCommand(t, binaryPath, []string{alias, "--help"}, []string{
+				"Work with non-admin resources like backups",
+				"nonadmin, na, non-admin, nad",
+			})
+			testutil.TestHelpCommand(t, binaryPath, []string{alias, "backup", "--help"}, []string{
+				"Work with non-admin backups",
+			})
+		})
+	}
+}
+
 // TestNonAdminHelpFlags tests that both --help and -h work for non-admin commands
 func TestNonAdminHelpFlags(t *testing.T) {
 	binaryPath := testutil.BuildCLIBinary(t)
@@ -0,0 +1,156 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nonadmin
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/migtools/oadp-cli/cmd/shared"
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+)
+
+// whoAmIResources are the non-admin resources checked for create permission
+var whoAmIResources = []string{
+	nacv1alpha1.NonAdminBackups,
+	nacv1alpha1.NonAdminRestores,
+	nacv1alpha1.NonAdminBackupStorageLocations,
+}
+
+// NewWhoAmICommand creates the "whoami" subcommand under nonadmin
+func NewWhoAmICommand(f client.Factory) *cobra.Command {
+	o := NewWhoAmIOptions()
+
+	c := &cobra.Command{
+		Use:   "whoami",
+		Short: "Show the effective namespace and non-admin permissions",
+		Long:  "Show the namespace non-admin commands target, the configured OADP admin namespace, and whether you can create non-admin resources",
+		Args:  cobra.NoArgs,
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args, f))
+			cmd.CheckError(o.Run(c))
+		},
+		Example: `  # Show which namespace non-admin commands will use
+  kubectl oadp nonadmin whoami`,
+	}
+
+	return c
+}
+
+// WhoAmIOptions holds the options for the whoami command
+type WhoAmIOptions struct {
+	Namespace      string
+	AdminNamespace string
+	client         kbclient.Client
+}
+
+// NewWhoAmIOptions creates a new WhoAmIOptions instance
+func NewWhoAmIOptions() *WhoAmIOptions {
+	return &WhoAmIOptions{}
+}
+
+// Complete resolves the namespaces and creates the client
+func (o *WhoAmIOptions) Complete(args []string, f client.Factory) error {
+	kbClient, err := shared.NewClientWithScheme(f, shared.ClientOptions{})
+	if err != nil {
+		return err
+	}
+	o.client = kbClient
+
+	currentNS, err := shared.GetCurrentNamespace()
+	if err != nil {
+		return fmt.Errorf("failed to determine current namespace: %w", err)
+	}
+	o.Namespace = currentNS
+
+	// Prefer the client config; otherwise look for the operator, which non-admin
+	// users usually aren't allowed to do
+	if clientConfig, err := shared.ReadVeleroClientConfig(); err == nil {
+		o.AdminNamespace = clientConfig.Namespace
+	}
+	if o.AdminNamespace == "" {
+		ctx, cancel := shared.RequestContext()
+		defer cancel()
+		o.AdminNamespace, _ = shared.DetectOADPNamespace(ctx, kbClient)
+	}
+
+	return nil
+}
+
+// Run prints the resolved namespaces and the create permission for each resource
+func (o *WhoAmIOptions) Run(c *cobra.Command) error {
+	ctx, cancel := shared.RequestContext()
+	defer cancel()
+
+	return printWhoAmI(ctx, c.OutOrStdout(), o.client, o.Namespace, o.AdminNamespace)
+}
+
+// printWhoAmI renders the whoami report, running a SelfSubjectAccessReview per resource
+func printWhoAmI(ctx context.Context, out io.Writer, kbClient kbclient.Client, namespace, adminNamespace string) error {
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+
+	fmt.Fprintf(w, "Current namespace:\t%s\n", namespace)
+	if adminNamespace != "" {
+		fmt.Fprintf(w, "OADP namespace:\t%s\n", adminNamespace)
+	} else {
+		fmt.Fprintf(w, "OADP namespace:\t<not configured> (set with `oadp client config set namespace=...`)\n")
+	}
+
+	fmt.Fprintf(w, "Permissions in namespace %q:\n", namespace)
+	for _, resource := range whoAmIResources {
+		allowed, err := canCreate(ctx, kbClient, namespace, resource)
+		switch {
+		case err != nil:
+			fmt.Fprintf(w, "  create %s:\tunknown (%v)\n", resource, err)
+		case allowed:
+			fmt.Fprintf(w, "  create %s:\tyes\n", resource)
+		default:
+			fmt.Fprintf(w, "  create %s:\tno\n", resource)
+		}
+	}
+
+	return w.Flush()
+}
+
+// canCreate asks the API server whether the current user can create the given
+// non-admin resource in the namespace
+func canCreate(ctx context.Context, kbClient kbclient.Client, namespace, resource string) (bool, error) {
+	review := &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace: namespace,
+				Verb:      "create",
+				Group:     nacv1alpha1.GroupVersion.Group,
+				Resource:  resource,
+			},
+		},
+	}
+
+	if err := kbClient.Create(ctx, review); err != nil {
+		return false, err
+	}
+
+	return review.Status.Allowed, nil
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nonadmin
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+)
+
+// TestPrintWhoAmI verifies the rendered capability lines using canned SSAR responses
+func TestPrintWhoAmI(t *testing.T) {
+	allowed := map[string]bool{
+		nacv1alpha1.NonAdminBackups:  true,
+		nacv1alpha1.NonAdminRestores: true,
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Create: func(ctx context.Context, c kbclient.WithWatch, obj kbclient.Object, opts ...kbclient.CreateOption) error {
+				review, ok := obj.(*authorizationv1.SelfSubjectAccessReview)
+				if !ok {
+					return c.Create(ctx, obj, opts...)
+				}
+				review.Status.Allowed = allowed[review.Spec.ResourceAttributes.Resource]
+				return nil
+			},
+		}).
+		Build()
+
+	var out bytes.Buffer
+	if err := printWhoAmI(context.Background(), &out, fakeClient, "my-project", "openshift-adp"); err != nil {
+		t.Fatalf("printWhoAmI returned error: %v", err)
+	}
+
+	expected := []string{
+		"Current namespace:  my-project",
+		"OADP namespace:     openshift-adp",
+		"create nonadminbackups:                 yes",
+		"create nonadminrestores:                yes",
+		"create nonadminbackupstoragelocations:  no",
+	}
+	for _, want := range expected {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("Expected output to contain %q, got:\n%s", want, out.String())
+		}
+	}
+}
+
+// TestPrintWhoAmIUnconfiguredAdminNamespace verifies the hint when no admin namespace is configured
+func TestPrintWhoAmIUnconfiguredAdminNamespace(t *testing.T) {
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+
+	var out bytes.Buffer
+	if err := printWhoAmI(context.Background(), &out, fakeClient, "my-project", ""); err != nil {
+		t.Fatalf("printWhoAmI returned error: %v", err)
+	}
+
+	if !strings.Contains(out.String(), "OADP namespace:     <not configured>") {
+		t.Errorf("Expected unconfigured admin namespace hint, got:\n%s", out.String())
+	}
+}
@@ -24,7 +24,9 @@ import (
 
 	"github.com/migtools/oadp-cli/cmd/nabsl-request"
 	nonadmin "github.com/migtools/oadp-cli/cmd/non-admin"
+	"github.com/migtools/oadp-cli/cmd/shared"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/backup"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/restore"
@@ -61,6 +63,17 @@ func NewVeleroRootCommand() *cobra.Command {
 		},
 	}
 
+	// --context is accepted everywhere as the kubectl spelling of --kubecontext
+	rootCmd.SetGlobalNormalizationFunc(func(_ *pflag.FlagSet, name string) pflag.NormalizedName {
+		if name == "context" {
+			name = "kubecontext"
+		}
+		return pflag.NormalizedName(name)
+	})
+
+	// Status markers and wait progress fall back to ASCII outside terminals; --no-color forces that everywhere
+	rootCmd.PersistentFlags().BoolVar(&shared.NoColor, "no-color", false, "Print plain ASCII status markers and progress dots instead of symbols, emoji and spinners")
+
 	// Create Velero client factory for regular Velero commands
 	// This factory is used to create clients for interacting with Velero resources.
 	veleroFactory := newVeleroFactory()
@@ -74,6 +87,9 @@ func NewVeleroRootCommand() *cobra.Command {
 	restoreCmd := restore.NewCommand(veleroFactory)
 	clientCmd := client.NewCommand()
 
+	// Data mover upload speeds for admins, reusing the non-admin data transfer helpers
+	addShowDataTransferFlag(backupCmd, veleroFactory)
+
 	// Modify help text to replace "velero" with "oadp"
 	updateCommandHelpText(backupCmd, usagePrefix)
 	updateCommandHelpText(restoreCmd, usagePrefix)
@@ -90,6 +106,16 @@ func NewVeleroRootCommand() *cobra.Command {
 	// Custom subcommands - use NonAdmin factory
 	rootCmd.AddCommand(nonadmin.NewNonAdminCommand(nonAdminFactory))
 
+	// Shell completion scripts, named for the plugin executable when installed as one
+	rootCmd.AddCommand(newCompletionCommand(usagePrefix))
+
+	// `oadp client config set default-output=yaml` changes the -o default of get commands
+	if clientConfig, err := readVeleroClientConfig(); err == nil {
+		if format := clientConfig.DefaultOutput; format != "" && !applyDefaultOutput(rootCmd, format) {
+			fmt.Fprintf(os.Stderr, "Warning: ignoring default-output %q, which no get command accepts\n", format)
+		}
+	}
+
 	return rootCmd
 }
 
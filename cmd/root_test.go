@@ -42,6 +42,7 @@ func TestRootCommand(t *testing.T) {
 				"restore",
 				"nabsl-request",
 				"nonadmin",
+				"completion",
 			},
 		},
 		{
@@ -123,3 +124,19 @@ func TestRootCommandSmoke(t *testing.T) {
 		})
 	}
 }
+
+// TestRootCommandContextFlag verifies --context is accepted as --kubecontext below the root
+func TestRootCommandContextFlag(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	c, _, err := NewVeleroRootCommand().Find([]string{"nabsl-request", "get"})
+	if err != nil {
+		t.Fatalf("Find() error = %v", err)
+	}
+	if err := c.ParseFlags([]string{"--context", "prod"}); err != nil {
+		t.Fatalf("ParseFlags() error = %v", err)
+	}
+	if got, _ := c.Flags().GetString("kubecontext"); got != "prod" {
+		t.Errorf("--kubecontext = %q, want %q", got, "prod")
+	}
+}
@@ -18,9 +18,11 @@ package shared
 
 import (
 	"fmt"
+	"io"
 
 	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
 	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -32,15 +34,23 @@ import (
 type ClientOptions struct {
 	// IncludeNonAdminTypes adds OADP NonAdmin CRD types to the scheme
 	IncludeNonAdminTypes bool
-	// IncludeVeleroTypes adds Velero CRD types to the scheme
+	// IncludeVeleroTypes adds Velero CRD types, including DataUploads, to the scheme
 	IncludeVeleroTypes bool
 	// IncludeCoreTypes adds Kubernetes core types to the scheme
 	IncludeCoreTypes bool
 }
 
+// clientOverride, when set, is returned by NewClientWithScheme instead of a client built
+// from the factory; see UseFakeClient
+var clientOverride kbclient.WithWatch
+
 // NewClientWithScheme creates a controller-runtime client with the specified scheme types
 func NewClientWithScheme(f client.Factory, opts ClientOptions) (kbclient.WithWatch, error) {
-	kbClient, err := f.KubebuilderWatchClient()
+	if clientOverride != nil {
+		return clientOverride, nil
+	}
+
+	kbClient, err := newWatchClient(f)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create controller-runtime client: %w", err)
 	}
@@ -56,6 +66,9 @@ func NewClientWithScheme(f client.Factory, opts ClientOptions) (kbclient.WithWat
 		if err := velerov1.AddToScheme(kbClient.Scheme()); err != nil {
 			return nil, fmt.Errorf("failed to add Velero types to scheme: %w", err)
 		}
+		if err := velerov2alpha1.AddToScheme(kbClient.Scheme()); err != nil {
+			return nil, fmt.Errorf("failed to add Velero v2alpha1 types to scheme: %w", err)
+		}
 	}
 
 	if opts.IncludeCoreTypes {
@@ -90,6 +103,9 @@ func NewSchemeWithTypes(opts ClientOptions) (*runtime.Scheme, error) {
 		if err := velerov1.AddToScheme(scheme); err != nil {
 			return nil, fmt.Errorf("failed to add Velero types to scheme: %w", err)
 		}
+		if err := velerov2alpha1.AddToScheme(scheme); err != nil {
+			return nil, fmt.Errorf("failed to add Velero v2alpha1 types to scheme: %w", err)
+		}
 	}
 
 	if opts.IncludeCoreTypes {
@@ -103,8 +119,26 @@ func NewSchemeWithTypes(opts ClientOptions) (*runtime.Scheme, error) {
 
 // GetCurrentNamespace gets the current namespace from the kubeconfig context
 func GetCurrentNamespace() (string, error) {
+	return GetContextNamespace("", "")
+}
+
+// PrintDefaultNamespaceHint points out that non-admin commands fell back to the
+// "default" namespace because the kubeconfig context sets none, which is rarely
+// where a non-admin user's backups live
+func PrintDefaultNamespaceHint(w io.Writer, namespace string) {
+	if namespace != "default" {
+		return
+	}
+	fmt.Fprintln(w, "Hint: using namespace 'default'; set your namespace with `kubectl config set-context --current --namespace=...`")
+}
+
+// GetContextNamespace gets the namespace of a kubeconfig context, using the same
+// kubeconfig and context the Velero factory connects with. Empty values fall back
+// to the default kubeconfig loading rules and the current context.
+func GetContextNamespace(kubeconfig, kubecontext string) (string, error) {
 	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
-	configOverrides := &clientcmd.ConfigOverrides{}
+	loadingRules.ExplicitPath = kubeconfig
+	configOverrides := &clientcmd.ConfigOverrides{CurrentContext: kubecontext}
 	kubeConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, configOverrides)
 
 	namespace, _, err := kubeConfig.Namespace()
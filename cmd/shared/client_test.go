@@ -0,0 +1,36 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestPrintDefaultNamespaceHint(t *testing.T) {
+	var out bytes.Buffer
+	PrintDefaultNamespaceHint(&out, "default")
+	if want := "Hint: using namespace 'default'"; !bytes.Contains(out.Bytes(), []byte(want)) {
+		t.Errorf("expected hint containing %q, got %q", want, out.String())
+	}
+
+	out.Reset()
+	PrintDefaultNamespaceHint(&out, "my-project")
+	if out.Len() != 0 {
+		t.Errorf("expected no hint for an explicit namespace, got %q", out.String())
+	}
+}
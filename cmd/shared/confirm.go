@@ -0,0 +1,103 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/pflag"
+)
+
+// BindConfirmFlags binds --confirm and its alias --assume-yes/-y, so every command that
+// prompts before a destructive change accepts either to skip the prompt
+func BindConfirmFlags(flags *pflag.FlagSet, value *bool) {
+	flags.BoolVar(value, "confirm", *value, "Skip confirmation prompts and proceed non-interactively")
+	flags.BoolVarP(value, "assume-yes", "y", *value, "Alias for --confirm")
+}
+
+// Confirm asks question on out and reads the answer from in. Only "y" or "yes" confirm;
+// an empty answer, or in ending before anything was typed, declines.
+func Confirm(in io.Reader, out io.Writer, question string) (bool, error) {
+	fmt.Fprintf(out, "%s (y/N): ", question)
+
+	response, err := readAnswer(in, out)
+	if err != nil {
+		return false, err
+	}
+
+	response = strings.ToLower(response)
+	return response == "y" || response == "yes", nil
+}
+
+// ConfirmTyped asks prompt on out and only confirms if the answer read from in is exactly
+// expected, for changes too large to confirm with y/N. Like Confirm, in ending before
+// anything was typed declines.
+func ConfirmTyped(in io.Reader, out io.Writer, prompt, expected string) (bool, error) {
+	fmt.Fprintf(out, "%s: ", prompt)
+
+	response, err := readAnswer(in, out)
+	if err != nil {
+		return false, err
+	}
+
+	return response == expected, nil
+}
+
+// readAnswer reads one line from in with surrounding spaces trimmed. in ending without
+// a newline is not an error: whatever was typed before it is the answer. Nothing past the
+// line is consumed, so answers to later prompts on the same in aren't lost.
+func readAnswer(in io.Reader, out io.Writer) (string, error) {
+	var response string
+	var err error
+	if reader, ok := in.(*bufio.Reader); ok {
+		response, err = reader.ReadString('\n')
+	} else {
+		response, err = readLine(in)
+	}
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", fmt.Errorf("failed to read user input: %w", err)
+	}
+	if errors.Is(err, io.EOF) {
+		// Nothing ends the prompt line when the input is closed
+		fmt.Fprintln(out)
+	}
+
+	return strings.TrimSpace(response), nil
+}
+
+// readLine reads from in up to and including the next newline one byte at a time, since a
+// buffered reader would keep whatever it read past the newline to itself
+func readLine(in io.Reader) (string, error) {
+	var line []byte
+	b := make([]byte, 1)
+	for {
+		n, err := in.Read(b)
+		if n > 0 {
+			line = append(line, b[0])
+			if b[0] == '\n' {
+				return string(line), nil
+			}
+		}
+		if err != nil {
+			return string(line), err
+		}
+	}
+}
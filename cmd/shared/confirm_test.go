@@ -0,0 +1,165 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/spf13/pflag"
+)
+
+func TestBindConfirmFlags(t *testing.T) {
+	for _, args := range [][]string{{"--confirm"}, {"--assume-yes"}, {"-y"}} {
+		var confirm bool
+		flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		BindConfirmFlags(flags, &confirm)
+
+		if err := flags.Parse(args); err != nil {
+			t.Fatalf("Parse(%v) error = %v", args, err)
+		}
+		if !confirm {
+			t.Errorf("expected %v to skip the prompt", args)
+		}
+	}
+
+	var confirm bool
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	BindConfirmFlags(flags, &confirm)
+	if err := flags.Parse(nil); err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if confirm {
+		t.Error("expected the prompt to be shown without either flag")
+	}
+}
+
+func TestConfirm(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		confirmed bool
+	}{
+		{name: "y", input: "y\n", confirmed: true},
+		{name: "yes", input: "yes\n", confirmed: true},
+		{name: "uppercase with spaces", input: "  YES \n", confirmed: true},
+		{name: "n", input: "n\n"},
+		{name: "anything else", input: "sure\n"},
+		{name: "empty", input: "\n"},
+		{name: "EOF", input: ""},
+		{name: "EOF after y", input: "y", confirmed: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			confirmed, err := Confirm(strings.NewReader(tt.input), &out, "Delete it?")
+			if err != nil {
+				t.Fatalf("Confirm() error = %v", err)
+			}
+			if confirmed != tt.confirmed {
+				t.Errorf("Confirm() = %v, want %v", confirmed, tt.confirmed)
+			}
+			if !strings.HasPrefix(out.String(), "Delete it? (y/N): ") {
+				t.Errorf("expected the question to be asked, got %q", out.String())
+			}
+		})
+	}
+}
+
+// TestConfirmSameReader verifies answers to several prompts can be read from the same
+// input, whether or not it is already buffered
+func TestConfirmSameReader(t *testing.T) {
+	for name, in := range map[string]io.Reader{
+		"unbuffered": strings.NewReader("y\nn\nmy-backup\n"),
+		"buffered":   bufio.NewReader(strings.NewReader("y\nn\nmy-backup\n")),
+	} {
+		t.Run(name, func(t *testing.T) {
+			var out bytes.Buffer
+			if confirmed, err := Confirm(in, &out, "First?"); err != nil || !confirmed {
+				t.Errorf("first Confirm() = %v, %v, want true", confirmed, err)
+			}
+			if confirmed, err := Confirm(in, &out, "Second?"); err != nil || confirmed {
+				t.Errorf("second Confirm() = %v, %v, want false", confirmed, err)
+			}
+			if confirmed, err := ConfirmTyped(in, &out, "Type the name", "my-backup"); err != nil || !confirmed {
+				t.Errorf("ConfirmTyped() = %v, %v, want true", confirmed, err)
+			}
+		})
+	}
+}
+
+func TestConfirmReadError(t *testing.T) {
+	readErr := errors.New("boom")
+	var out bytes.Buffer
+	confirmed, err := Confirm(iotest.ErrReader(readErr), &out, "Delete it?")
+	if !errors.Is(err, readErr) {
+		t.Errorf("Confirm() error = %v, want %v", err, readErr)
+	}
+	if confirmed {
+		t.Error("expected a read error not to confirm")
+	}
+}
+
+func TestConfirmTyped(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		confirmed bool
+	}{
+		{name: "expected answer", input: "12\n", confirmed: true},
+		{name: "expected answer with spaces", input: " 12 \n", confirmed: true},
+		{name: "wrong answer", input: "11\n"},
+		{name: "y is not enough", input: "y\n"},
+		{name: "empty", input: "\n"},
+		{name: "EOF", input: ""},
+		{name: "EOF after the expected answer", input: "12", confirmed: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			confirmed, err := ConfirmTyped(strings.NewReader(tt.input), &out, "Type 12 to confirm", "12")
+			if err != nil {
+				t.Fatalf("ConfirmTyped() error = %v", err)
+			}
+			if confirmed != tt.confirmed {
+				t.Errorf("ConfirmTyped() = %v, want %v", confirmed, tt.confirmed)
+			}
+			if !strings.HasPrefix(out.String(), "Type 12 to confirm: ") {
+				t.Errorf("expected the prompt to be shown, got %q", out.String())
+			}
+		})
+	}
+}
+
+func TestConfirmTypedReadError(t *testing.T) {
+	readErr := errors.New("boom")
+	var out bytes.Buffer
+	confirmed, err := ConfirmTyped(iotest.ErrReader(readErr), &out, "Type 12 to confirm", "12")
+	if !errors.Is(err, readErr) {
+		t.Errorf("ConfirmTyped() error = %v, want %v", err, readErr)
+	}
+	if confirmed {
+		t.Error("expected a read error not to confirm")
+	}
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+)
+
+// NonAdminKinds are the main kinds served by the OADP non-admin controller (NAC) CRDs
+var NonAdminKinds = []string{"NonAdminBackup", "NonAdminRestore", "NonAdminBackupStorageLocation"}
+
+// ErrNonAdminNotInstalled is returned by EnsureNonAdminCRDs when the NAC CRDs are missing
+var ErrNonAdminNotInstalled = errors.New("OADP non-admin controller (NAC) is not installed in this cluster")
+
+// EnsureNonAdminCRDs checks that the NonAdminBackup CRD is installed, so non-admin
+// commands can fail with ErrNonAdminNotInstalled instead of a raw "no matches for kind" error
+func EnsureNonAdminCRDs(kbClient kbclient.Client) error {
+	missing, err := MissingNonAdminKinds(kbClient.RESTMapper(), "NonAdminBackup")
+	if err != nil {
+		return fmt.Errorf("failed to check for the OADP non-admin CRDs: %w", err)
+	}
+	if len(missing) > 0 {
+		return ErrNonAdminNotInstalled
+	}
+	return nil
+}
+
+// PreviewAnnotation marks create commands that only print the object they would submit
+// when run with -o and without --wait or --dry-run=server, so they never contact NAC
+const PreviewAnnotation = "oadp.openshift.io/client-side-preview"
+
+// NonAdminPreRun returns the PersistentPreRun for the non-admin command groups. It fails
+// early with a clear message when NAC isn't installed, except for client-side previews.
+func NonAdminPreRun(f client.Factory) func(c *cobra.Command, args []string) {
+	return func(c *cobra.Command, args []string) {
+		if isClientSidePreview(c) {
+			return
+		}
+		kbClient, err := NewClientWithScheme(f, ClientOptions{IncludeNonAdminTypes: true})
+		cmd.CheckError(err)
+		cmd.CheckError(EnsureNonAdminCRDs(kbClient))
+	}
+}
+
+// isClientSidePreview reports whether c is a PreviewAnnotation command run with -o and
+// without --wait or --dry-run=server
+func isClientSidePreview(c *cobra.Command) bool {
+	if _, ok := c.Annotations[PreviewAnnotation]; !ok {
+		return false
+	}
+	if output.GetOutputFlagValue(c) == "" {
+		return false
+	}
+	if dryRun := c.Flags().Lookup("dry-run"); dryRun != nil && dryRun.Value.String() == DryRunServer {
+		return false
+	}
+	wait := c.Flags().Lookup("wait")
+	return wait == nil || wait.Value.String() != "true"
+}
+
+// MissingNonAdminKinds returns the kinds the API server doesn't serve, as reported by the
+// REST mapper. Unlike reading the CRDs themselves, this works without cluster-wide access.
+func MissingNonAdminKinds(mapper meta.RESTMapper, kinds ...string) ([]string, error) {
+	var missing []string
+	for _, kind := range kinds {
+		gk := schema.GroupKind{Group: nacv1alpha1.GroupVersion.Group, Kind: kind}
+		if _, err := mapper.RESTMapping(gk, nacv1alpha1.GroupVersion.Version); err != nil {
+			if meta.IsNoMatchError(err) {
+				missing = append(missing, kind)
+				continue
+			}
+			return nil, err
+		}
+	}
+	return missing, nil
+}
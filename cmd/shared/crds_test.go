@@ -0,0 +1,117 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/discovery/cached/memory"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/restmapper"
+	clienttesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+)
+
+// TestEnsureNonAdminCRDs verifies the preflight against discovery with and without the NAC CRDs
+func TestEnsureNonAdminCRDs(t *testing.T) {
+	nonAdminResources := &metav1.APIResourceList{
+		GroupVersion: nacv1alpha1.GroupVersion.String(),
+		APIResources: []metav1.APIResource{
+			{Name: "nonadminbackups", SingularName: "nonadminbackup", Namespaced: true, Kind: "NonAdminBackup"},
+		},
+	}
+	coreResources := &metav1.APIResourceList{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{
+			{Name: "pods", SingularName: "pod", Namespaced: true, Kind: "Pod"},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		resources []*metav1.APIResourceList
+		expectErr error
+	}{
+		{
+			name:      "NAC installed",
+			resources: []*metav1.APIResourceList{coreResources, nonAdminResources},
+		},
+		{
+			name:      "NAC not installed",
+			resources: []*metav1.APIResourceList{coreResources},
+			expectErr: ErrNonAdminNotInstalled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			discovery := &fakediscovery.FakeDiscovery{Fake: &clienttesting.Fake{Resources: tt.resources}}
+			mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(discovery))
+			client := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRESTMapper(mapper).Build()
+
+			err := EnsureNonAdminCRDs(client)
+			if !errors.Is(err, tt.expectErr) {
+				t.Errorf("EnsureNonAdminCRDs() error = %v, want %v", err, tt.expectErr)
+			}
+		})
+	}
+}
+
+// TestIsClientSidePreview verifies the NAC check is only skipped for annotated create
+// commands printing with -o, not waiting and not submitting a server dry run
+func TestIsClientSidePreview(t *testing.T) {
+	tests := []struct {
+		name      string
+		annotated bool
+		args      []string
+		expected  bool
+	}{
+		{name: "preview", annotated: true, args: []string{"-o", "yaml"}, expected: true},
+		{name: "no output", annotated: true},
+		{name: "waiting for the result", annotated: true, args: []string{"-o", "json", "--wait"}},
+		{name: "server dry run", annotated: true, args: []string{"-o", "json", "--dry-run", "server"}},
+		{name: "not a create command", args: []string{"-o", "yaml"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &cobra.Command{}
+			if tt.annotated {
+				c.Annotations = map[string]string{PreviewAnnotation: ""}
+			}
+			output.BindFlags(c.Flags())
+			output.ClearOutputFlagDefault(c)
+			c.Flags().Bool("wait", false, "")
+			var dryRun string
+			BindDryRunFlag(c.Flags(), &dryRun)
+			if err := c.Flags().Parse(tt.args); err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if got := isClientSidePreview(c); got != tt.expected {
+				t.Errorf("isClientSidePreview() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
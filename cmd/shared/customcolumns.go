@@ -0,0 +1,140 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// CustomColumnsPrefix is the --output value prefix selecting custom columns
+const CustomColumnsPrefix = "custom-columns="
+
+// CustomColumn is a single NAME:.json.path column of a custom-columns spec
+type CustomColumn struct {
+	Header string
+	Path   string
+}
+
+// ParseCustomColumns parses a comma separated list of NAME:.json.path column specs,
+// e.g. "NAME:.metadata.name,PHASE:.status.phase"
+func ParseCustomColumns(spec string) ([]CustomColumn, error) {
+	if strings.TrimSpace(spec) == "" {
+		return nil, fmt.Errorf("custom-columns format specified but no custom columns given")
+	}
+
+	var columns []CustomColumn
+	for _, part := range strings.Split(spec, ",") {
+		header, path, found := strings.Cut(part, ":")
+		header = strings.TrimSpace(header)
+		path = strings.TrimSpace(path)
+		if !found || header == "" || path == "" {
+			return nil, fmt.Errorf("invalid custom-columns spec %q, expected NAME:.json.path", part)
+		}
+
+		// Accept both ".metadata.name" and "{.metadata.name}"
+		if !strings.HasPrefix(path, "{") {
+			path = "{" + path + "}"
+		}
+		if _, err := jsonpath.Parse(header, path); err != nil {
+			return nil, fmt.Errorf("invalid custom-columns path %q: %w", path, err)
+		}
+
+		columns = append(columns, CustomColumn{Header: header, Path: path})
+	}
+
+	return columns, nil
+}
+
+// PrintCustomColumns prints obj, or every item of obj if it is a list, as a table
+// with one column per custom column
+func PrintCustomColumns(w io.Writer, obj runtime.Object, columns []CustomColumn, noHeaders bool) error {
+	items := []runtime.Object{obj}
+	if meta.IsListType(obj) {
+		var err error
+		if items, err = meta.ExtractList(obj); err != nil {
+			return fmt.Errorf("failed to extract list items: %w", err)
+		}
+	}
+
+	parsers := make([]*jsonpath.JSONPath, len(columns))
+	for i, column := range columns {
+		parser := jsonpath.New(column.Header).AllowMissingKeys(true)
+		if err := parser.Parse(column.Path); err != nil {
+			return fmt.Errorf("invalid custom-columns path %q: %w", column.Path, err)
+		}
+		parsers[i] = parser
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	defer tw.Flush()
+
+	if !noHeaders {
+		headers := make([]string, len(columns))
+		for i, column := range columns {
+			headers[i] = column.Header
+		}
+		fmt.Fprintln(tw, strings.Join(headers, "\t"))
+	}
+
+	for _, item := range items {
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(item)
+		if err != nil {
+			return fmt.Errorf("failed to convert object: %w", err)
+		}
+
+		values := make([]string, len(parsers))
+		for i, parser := range parsers {
+			var buf bytes.Buffer
+			if err := parser.Execute(&buf, content); err != nil {
+				return fmt.Errorf("failed to evaluate %q: %w", columns[i].Path, err)
+			}
+			values[i] = buf.String()
+			if values[i] == "" {
+				values[i] = "<none>"
+			}
+		}
+		fmt.Fprintln(tw, strings.Join(values, "\t"))
+	}
+
+	return nil
+}
+
+// PrintWithCustomColumns prints obj if the --output flag selects custom-columns and
+// reports whether it did, mirroring output.PrintWithFormat
+func PrintWithCustomColumns(c *cobra.Command, obj runtime.Object, noHeaders bool) (bool, error) {
+	format := output.GetOutputFlagValue(c)
+	if !strings.HasPrefix(format, CustomColumnsPrefix) {
+		return false, nil
+	}
+
+	columns, err := ParseCustomColumns(strings.TrimPrefix(format, CustomColumnsPrefix))
+	if err != nil {
+		return false, err
+	}
+
+	return true, PrintCustomColumns(c.OutOrStdout(), obj, columns, noHeaders)
+}
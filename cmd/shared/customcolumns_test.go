@@ -0,0 +1,110 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+)
+
+func TestParseCustomColumns(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    string
+		want    []CustomColumn
+		wantErr bool
+	}{
+		{
+			name: "relaxed and braced paths",
+			spec: "NAME:.metadata.name,PHASE:{.status.phase}",
+			want: []CustomColumn{
+				{Header: "NAME", Path: "{.metadata.name}"},
+				{Header: "PHASE", Path: "{.status.phase}"},
+			},
+		},
+		{name: "empty spec", spec: "", wantErr: true},
+		{name: "missing path", spec: "NAME", wantErr: true},
+		{name: "missing header", spec: ":.metadata.name", wantErr: true},
+		{name: "invalid path", spec: "NAME:.metadata[", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseCustomColumns(tt.spec)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseCustomColumns(%q) error = %v, wantErr %v", tt.spec, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("ParseCustomColumns(%q) = %v, want %v", tt.spec, got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("column %d = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestPrintCustomColumns(t *testing.T) {
+	list := &nacv1alpha1.NonAdminBackupList{
+		Items: []nacv1alpha1.NonAdminBackup{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "backup-a", Namespace: "my-project"},
+				Status:     nacv1alpha1.NonAdminBackupStatus{Phase: nacv1alpha1.NonAdminPhaseCreated},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "backup-b", Namespace: "my-project"},
+			},
+		},
+	}
+
+	columns, err := ParseCustomColumns("NAME:.metadata.name,PHASE:.status.phase")
+	if err != nil {
+		t.Fatalf("ParseCustomColumns() error = %v", err)
+	}
+
+	var out bytes.Buffer
+	if err := PrintCustomColumns(&out, list, columns, false); err != nil {
+		t.Fatalf("PrintCustomColumns() error = %v", err)
+	}
+
+	want := []string{
+		"NAME      PHASE",
+		"backup-a  Created",
+		"backup-b  <none>",
+	}
+	if got := strings.Split(strings.TrimSpace(out.String()), "\n"); strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("unexpected output:\n%s\nwant:\n%s", out.String(), strings.Join(want, "\n"))
+	}
+
+	out.Reset()
+	if err := PrintCustomColumns(&out, &list.Items[0], columns, true); err != nil {
+		t.Fatalf("PrintCustomColumns() error = %v", err)
+	}
+	if got := strings.TrimSpace(out.String()); got != "backup-a  Created" {
+		t.Errorf("unexpected single object output without headers: %q", got)
+	}
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// CalculateTransferSpeed returns the transfer speed in bytes per second, or 0 when
+// no time has elapsed
+func CalculateTransferSpeed(bytes int64, elapsed time.Duration) float64 {
+	if elapsed <= 0 {
+		return 0
+	}
+	return float64(bytes) / elapsed.Seconds()
+}
+
+// UploadSpeed aggregates the bytes moved by the uploads over the time from the first one
+// starting to the last one finishing, counting uploads still in progress up to now.
+// ok is false when none of the uploads has started.
+func UploadSpeed(uploads []velerov2alpha1.DataUpload, now time.Time) (speed string, ok bool) {
+	var (
+		totalBytes  int64
+		first, last time.Time
+		counted     int
+	)
+	for _, du := range uploads {
+		if du.Status.StartTimestamp == nil {
+			continue
+		}
+
+		var end time.Time
+		switch du.Status.Phase {
+		case velerov2alpha1.DataUploadPhaseCompleted:
+			if du.Status.CompletionTimestamp == nil {
+				continue
+			}
+			end = du.Status.CompletionTimestamp.Time
+		case velerov2alpha1.DataUploadPhaseInProgress:
+			end = now
+		default:
+			continue
+		}
+
+		totalBytes += du.Status.Progress.BytesDone
+		if first.IsZero() || du.Status.StartTimestamp.Time.Before(first) {
+			first = du.Status.StartTimestamp.Time
+		}
+		if end.After(last) {
+			last = end
+		}
+		counted++
+	}
+	if counted == 0 {
+		return "", false
+	}
+
+	return FormatBytes(int64(CalculateTransferSpeed(totalBytes, last.Sub(first)))) + "/s", true
+}
+
+// UploadNodes returns the sorted, distinct nodes that handled the uploads
+func UploadNodes(uploads []velerov2alpha1.DataUpload) []string {
+	var nodes []string
+	for _, du := range uploads {
+		if du.Status.Node != "" && !slices.Contains(nodes, du.Status.Node) {
+			nodes = append(nodes, du.Status.Node)
+		}
+	}
+	slices.Sort(nodes)
+	return nodes
+}
+
+// DataTransfer summarizes the DataUploads of one Velero backup
+type DataTransfer struct {
+	// Speed is the upload speed, or "" when no upload has started; see UploadSpeed
+	Speed string
+	// Nodes are the nodes that handled the uploads; see UploadNodes
+	Nodes []string
+}
+
+// DataTransfers lists the DataUploads in the namespace once and summarizes the uploads of
+// every Velero backup that has any, keyed by Velero backup name
+func DataTransfers(ctx context.Context, kbClient kbclient.Client, namespace string, now time.Time) (map[string]DataTransfer, error) {
+	var uploads velerov2alpha1.DataUploadList
+	if err := kbClient.List(ctx, &uploads, kbclient.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list DataUploads: %w", err)
+	}
+
+	uploadsByBackup := make(map[string][]velerov2alpha1.DataUpload)
+	for _, du := range uploads.Items {
+		backupName := du.Labels[velerov1.BackupNameLabel]
+		uploadsByBackup[backupName] = append(uploadsByBackup[backupName], du)
+	}
+
+	transfers := make(map[string]DataTransfer)
+	for backupName, backupUploads := range uploadsByBackup {
+		speed, _ := UploadSpeed(backupUploads, now)
+		transfers[backupName] = DataTransfer{Speed: speed, Nodes: UploadNodes(backupUploads)}
+	}
+	return transfers, nil
+}
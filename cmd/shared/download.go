@@ -0,0 +1,251 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DownloadRequestOptions controls how a NonAdminDownloadRequest is polled
+type DownloadRequestOptions struct {
+	// PollInterval is how often the request status is checked (defaults to 1s)
+	PollInterval time.Duration
+	// OnPoll is called before each status check, e.g. to print progress dots
+	OnPoll func()
+}
+
+// RequestDownloadURL creates a NonAdminDownloadRequest for the given target and waits
+// until the controller has processed it, returning the signed download URL.
+// The request is deleted before returning. The wait is bounded by the context deadline.
+//
+// The target name is the non-admin object name (e.g. the NonAdminBackup name); the
+// controller resolves it to the underlying Velero object.
+func RequestDownloadURL(ctx context.Context, kbClient kbclient.Client, namespace string, kind velerov1.DownloadTargetKind, name string, opts DownloadRequestOptions) (string, error) {
+	req := &nacv1alpha1.NonAdminDownloadRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: name + "-" + strings.ToLower(string(kind)) + "-",
+			Namespace:    namespace,
+		},
+		Spec: nacv1alpha1.NonAdminDownloadRequestSpec{
+			Target: velerov1.DownloadTarget{
+				Kind: kind,
+				Name: name,
+			},
+		},
+	}
+
+	if err := kbClient.Create(ctx, req); err != nil {
+		return "", fmt.Errorf("failed to create NonAdminDownloadRequest for %s: %w", kind, err)
+	}
+
+	// Clean up the download request when done
+	defer func() {
+		deleteCtx, cancelDelete := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancelDelete()
+		_ = kbClient.Delete(deleteCtx, req)
+	}()
+
+	pollInterval := opts.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = time.Second
+	}
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		if opts.OnPoll != nil {
+			opts.OnPoll()
+		}
+
+		var updated nacv1alpha1.NonAdminDownloadRequest
+		if err := kbClient.Get(ctx, kbclient.ObjectKey{
+			Namespace: req.Namespace,
+			Name:      req.Name,
+		}, &updated); err != nil {
+			return "", fmt.Errorf("failed to get NonAdminDownloadRequest: %w", err)
+		}
+
+		// Check if the download request was processed successfully
+		for _, condition := range updated.Status.Conditions {
+			if condition.Type == string(nacv1alpha1.ConditionNonAdminProcessed) && condition.Status == metav1.ConditionTrue {
+				if status := updated.Status.VeleroDownloadRequest.Status; status != nil && status.DownloadURL != "" {
+					return status.DownloadURL, nil
+				}
+			}
+		}
+
+		// Check for failure conditions
+		for _, condition := range updated.Status.Conditions {
+			if condition.Status == metav1.ConditionTrue && condition.Reason == "Error" {
+				return "", fmt.Errorf("NonAdminDownloadRequest failed for %s: %s - %s", kind, condition.Type, condition.Message)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return "", fmt.Errorf("timed out waiting for %s download request to be processed", kind)
+		case <-ticker.C:
+		}
+	}
+}
+
+// DownloadHTTPOptions configures the HTTP client used to fetch signed download URLs
+type DownloadHTTPOptions struct {
+	// InsecureSkipTLSVerify is only meant for object storage with self-signed certificates
+	InsecureSkipTLSVerify bool
+	// Proxy overrides the HTTP(S)_PROXY/NO_PROXY environment when set
+	Proxy string
+}
+
+// BindFlags binds the download flags shared by commands that fetch signed URLs
+func (o *DownloadHTTPOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.BoolVar(&o.InsecureSkipTLSVerify, "insecure-skip-tls-verify", false, "Skip TLS certificate verification when downloading from object storage. This is insecure and should only be used with self-signed certificates")
+	flags.StringVar(&o.Proxy, "proxy", "", "Proxy URL to use when downloading from object storage (defaults to the HTTPS_PROXY/HTTP_PROXY environment)")
+}
+
+// NewDownloadHTTPClient returns the HTTP client used to fetch signed download URLs.
+// TLS verification is on and the proxy is taken from the environment unless the
+// options say otherwise.
+func NewDownloadHTTPClient(opts DownloadHTTPOptions) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = http.ProxyFromEnvironment
+
+	if opts.Proxy != "" {
+		proxyURL, err := url.Parse(opts.Proxy)
+		if err != nil || proxyURL.Scheme == "" || proxyURL.Host == "" {
+			return nil, fmt.Errorf("invalid --proxy %q: expected a URL like http://proxy.example.com:3128", opts.Proxy)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	if opts.InsecureSkipTLSVerify {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true} // #nosec G402 -- opt-in via --insecure-skip-tls-verify
+	}
+
+	return &http.Client{Transport: transport}, nil
+}
+
+// DownloadContent fetches content from a signed URL and returns it as a string,
+// decompressing it when the server marks it as gzip-encoded
+func DownloadContent(httpClient *http.Client, url string) (string, error) {
+	resp, err := httpClient.Get(url)
+	if err != nil {
+		return "", fmt.Errorf("failed to download content from URL %q: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return "", fmt.Errorf("failed to download content: status %s, body: %s", resp.Status, string(bodyBytes))
+	}
+
+	// Try to decompress if it's gzipped
+	var reader io.Reader = resp.Body
+	if strings.Contains(resp.Header.Get("Content-Encoding"), "gzip") {
+		gzr, err := gzip.NewReader(resp.Body)
+		if err != nil {
+			return "", fmt.Errorf("failed to create gzip reader: %w", err)
+		}
+		defer gzr.Close()
+		reader = gzr
+	}
+
+	// Read all content
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return "", fmt.Errorf("failed to read content: %w", err)
+	}
+
+	return string(content), nil
+}
+
+// DownloadRaw copies the content of a signed URL to w as-is, without decompressing it
+func DownloadRaw(httpClient *http.Client, url string, w io.Writer) error {
+	resp, err := httpClient.Get(url)
+	if err != nil {
+		return fmt.Errorf("failed to download content from URL %q: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("failed to download content: status %s, body: %s", resp.Status, string(bodyBytes))
+	}
+
+	if _, err := io.Copy(w, resp.Body); err != nil {
+		return fmt.Errorf("failed to read content: %w", err)
+	}
+
+	return nil
+}
+
+// StreamGzippedLines downloads a gzip-compressed file (such as Velero logs) from a
+// signed URL and writes it line by line to w
+func StreamGzippedLines(httpClient *http.Client, url string, w io.Writer) error {
+	return StreamFilteredGzippedLines(httpClient, url, w, nil)
+}
+
+// StreamFilteredGzippedLines is like StreamGzippedLines but only writes the lines
+// accepted by filter. A nil filter accepts every line.
+func StreamFilteredGzippedLines(httpClient *http.Client, url string, w io.Writer, filter LineFilter) error {
+	resp, err := httpClient.Get(url)
+	if err != nil {
+		return fmt.Errorf("failed to download logs from URL %q: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("failed to download logs: status %s, body: %s", resp.Status, string(bodyBytes))
+	}
+
+	gzr, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to create gzip reader: %w", err)
+	}
+	defer gzr.Close()
+
+	scanner := bufio.NewScanner(gzr)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if filter != nil && !filter(line) {
+			continue
+		}
+		fmt.Fprintln(w, line)
+	}
+	if err := scanner.Err(); err != nil && err != io.EOF {
+		return fmt.Errorf("failed to read logs: %w", err)
+	}
+
+	return nil
+}
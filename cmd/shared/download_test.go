@@ -0,0 +1,75 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newTestDownloadClient(t *testing.T, opts DownloadHTTPOptions) *http.Client {
+	t.Helper()
+
+	httpClient, err := NewDownloadHTTPClient(opts)
+	if err != nil {
+		t.Fatalf("NewDownloadHTTPClient() error = %v", err)
+	}
+	return httpClient
+}
+
+func TestNewDownloadHTTPClientTLSVerification(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("signed content"))
+	}))
+	defer server.Close()
+
+	// The test server uses a self-signed certificate
+	if _, err := DownloadContent(newTestDownloadClient(t, DownloadHTTPOptions{}), server.URL); err == nil {
+		t.Error("expected certificate verification to fail by default")
+	}
+
+	content, err := DownloadContent(newTestDownloadClient(t, DownloadHTTPOptions{InsecureSkipTLSVerify: true}), server.URL)
+	if err != nil {
+		t.Fatalf("expected download to succeed when skipping verification, got %v", err)
+	}
+	if content != "signed content" {
+		t.Errorf("DownloadContent() = %q, want %q", content, "signed content")
+	}
+}
+
+func TestNewDownloadHTTPClientProxy(t *testing.T) {
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Requests sent through a proxy carry the absolute target URL
+		proxied = r.URL.String()
+		_, _ = w.Write([]byte("via proxy"))
+	}))
+	defer proxy.Close()
+
+	content, err := DownloadContent(newTestDownloadClient(t, DownloadHTTPOptions{Proxy: proxy.URL}), "http://object-storage.invalid/backup.log")
+	if err != nil {
+		t.Fatalf("DownloadContent() error = %v", err)
+	}
+	if content != "via proxy" || proxied != "http://object-storage.invalid/backup.log" {
+		t.Errorf("expected the request to go through the proxy, got content %q and proxied URL %q", content, proxied)
+	}
+
+	if _, err := NewDownloadHTTPClient(DownloadHTTPOptions{Proxy: "not a url"}); err == nil {
+		t.Error("expected an error for an invalid --proxy")
+	}
+}
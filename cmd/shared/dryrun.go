@@ -0,0 +1,76 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DryRunServer is the --dry-run value that submits an object for server-side validation
+// and admission without persisting it
+const DryRunServer = "server"
+
+// BindDryRunFlag binds --dry-run for create commands
+func BindDryRunFlag(flags *pflag.FlagSet, value *string) {
+	flags.StringVar(value, "dry-run", "none", `Must be "none" or "server". If server, submit the object for validation and admission without persisting it, and print what the server returned as yaml, or with -o json as json. Without --dry-run, use -o yaml for a client-side preview.`)
+}
+
+// ValidateDryRun checks a --dry-run value
+func ValidateDryRun(value string) error {
+	switch value {
+	case "", "none", DryRunServer:
+		return nil
+	case "client":
+		return errors.New("--dry-run=client is not supported, use -o yaml to preview the object without contacting the server")
+	}
+	return fmt.Errorf(`invalid --dry-run value %q, must be "none" or "server"`, value)
+}
+
+// DryRunOutputFormat returns the format the object returned by --dry-run=server is
+// printed in: the -o json or yaml value, defaulting to yaml
+func DryRunOutputFormat(c *cobra.Command) (string, error) {
+	switch format := output.GetOutputFlagValue(c); format {
+	case "":
+		return "yaml", nil
+	case "json", "yaml":
+		return format, nil
+	default:
+		return "", fmt.Errorf("--dry-run=server can only be used with -o json or -o yaml")
+	}
+}
+
+// DryRunCreateOptions returns the create options for a --dry-run value
+func DryRunCreateOptions(value string) []kbclient.CreateOption {
+	if value == DryRunServer {
+		return []kbclient.CreateOption{kbclient.DryRunAll}
+	}
+	return nil
+}
+
+// DryRunUpdateOptions returns the update options for a --dry-run value
+func DryRunUpdateOptions(value string) []kbclient.UpdateOption {
+	if value == DryRunServer {
+		return []kbclient.UpdateOption{kbclient.DryRunAll}
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
+)
+
+// TestValidateDryRun verifies the accepted --dry-run values
+func TestValidateDryRun(t *testing.T) {
+	tests := []struct {
+		value       string
+		expectError string
+	}{
+		{value: ""},
+		{value: "none"},
+		{value: DryRunServer},
+		{value: "client", expectError: "use -o yaml"},
+		{value: "all", expectError: `invalid --dry-run value "all"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			err := ValidateDryRun(tt.value)
+			if tt.expectError == "" {
+				if err != nil {
+					t.Errorf("ValidateDryRun() unexpected error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+				t.Errorf("ValidateDryRun() error = %v, want it to contain %q", err, tt.expectError)
+			}
+		})
+	}
+}
+
+// TestDryRunOutputFormat verifies a server dry run prints yaml unless -o json is given,
+// and rejects other formats
+func TestDryRunOutputFormat(t *testing.T) {
+	tests := []struct {
+		args        []string
+		expect      string
+		expectError bool
+	}{
+		{expect: "yaml"},
+		{args: []string{"-o", "json"}, expect: "json"},
+		{args: []string{"-o", "yaml"}, expect: "yaml"},
+		{args: []string{"-o", "table"}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.args, " "), func(t *testing.T) {
+			c := &cobra.Command{}
+			output.BindFlags(c.Flags())
+			output.ClearOutputFlagDefault(c)
+			if err := c.Flags().Parse(tt.args); err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			format, err := DryRunOutputFormat(c)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("DryRunOutputFormat() = %q, want an error", format)
+				}
+				return
+			}
+			if err != nil || format != tt.expect {
+				t.Errorf("DryRunOutputFormat() = %q, %v, want %q", format, err, tt.expect)
+			}
+		})
+	}
+}
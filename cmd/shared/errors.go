@@ -0,0 +1,94 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// TranslatedError is a short, user-friendly error that keeps the underlying error,
+// so errors.Is/As and errors.Unwrap still reach the original API error
+type TranslatedError struct {
+	Message string
+	Err     error
+}
+
+func (e *TranslatedError) Error() string {
+	return e.Message
+}
+
+func (e *TranslatedError) Unwrap() error {
+	return e.Err
+}
+
+// TranslateError converts verbose Kubernetes errors about the named object of the given
+// kind (e.g. "backup") into user-friendly messages, wrapping the original error
+func TranslateError(kind, name string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &TranslatedError{Message: translatedMessage(kind, name, err), Err: err}
+}
+
+// NotFoundError translates err for an object missing from namespace, e.g.
+// "backup 'nightly' not found in namespace 'my-project'", adding a hint when any
+// of similar, typically the result of Suggestions, may be what the user meant
+func NotFoundError(kind, name, namespace string, similar []string, err error) error {
+	message := fmt.Sprintf("%s '%s' not found in namespace '%s'", kind, name, namespace)
+	if hint := DidYouMean(similar); hint != "" {
+		message += "; " + hint
+	}
+	return &TranslatedError{Message: message, Err: err}
+}
+
+// translatedMessage returns the user-friendly message for err
+func translatedMessage(kind, name string, err error) string {
+	switch {
+	case apierrors.IsNotFound(err):
+		return fmt.Sprintf("%s '%s' not found", kind, name)
+	case apierrors.IsForbidden(err):
+		return "permission denied"
+	case apierrors.IsUnauthorized(err):
+		return "authentication required"
+	case apierrors.IsConflict(err):
+		return fmt.Sprintf("%s '%s' was modified, please try again", kind, name)
+	case apierrors.IsTimeout(err), errors.Is(err, context.DeadlineExceeded):
+		return "request timed out"
+	case apierrors.IsServerTimeout(err):
+		return "server timeout"
+	case apierrors.IsServiceUnavailable(err):
+		return "service unavailable"
+	}
+
+	// Check for common connection issues
+	errStr := err.Error()
+	if strings.Contains(errStr, "connection refused") {
+		return "cannot connect to cluster"
+	}
+	if strings.Contains(errStr, "no such host") {
+		return "cannot reach cluster"
+	}
+
+	// For any other error, provide a generic message
+	return "operation failed"
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestTranslateError(t *testing.T) {
+	resource := schema.GroupResource{Group: "oadp.openshift.io", Resource: "nonadminbackups"}
+
+	tests := []struct {
+		name   string
+		err    error
+		expect string
+	}{
+		{
+			name:   "not found",
+			err:    apierrors.NewNotFound(resource, "my-backup"),
+			expect: "backup 'my-backup' not found",
+		},
+		{
+			name:   "forbidden",
+			err:    apierrors.NewForbidden(resource, "my-backup", errors.New("no access")),
+			expect: "permission denied",
+		},
+		{
+			name:   "conflict",
+			err:    apierrors.NewConflict(resource, "my-backup", errors.New("object was modified")),
+			expect: "backup 'my-backup' was modified, please try again",
+		},
+		{
+			name:   "deadline exceeded",
+			err:    fmt.Errorf("Get \"https://127.0.0.1:6443/apis\": %w", context.DeadlineExceeded),
+			expect: "request timed out",
+		},
+		{
+			name:   "connection refused",
+			err:    fmt.Errorf("dial tcp 127.0.0.1:6443: connect: connection refused"),
+			expect: "cannot connect to cluster",
+		},
+		{
+			name:   "other",
+			err:    errors.New("something unexpected"),
+			expect: "operation failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := TranslateError("backup", "my-backup", tt.err)
+			if err.Error() != tt.expect {
+				t.Errorf("TranslateError() = %q, want %q", err.Error(), tt.expect)
+			}
+			if errors.Unwrap(err) != tt.err {
+				t.Errorf("errors.Unwrap() = %v, want the original error %v", errors.Unwrap(err), tt.err)
+			}
+			var translated *TranslatedError
+			if !errors.As(err, &translated) {
+				t.Errorf("expected a *TranslatedError, got %T", err)
+			}
+		})
+	}
+
+	if err := TranslateError("backup", "my-backup", nil); err != nil {
+		t.Errorf("TranslateError(nil) = %v, want nil", err)
+	}
+}
+
+// TestTranslateErrorKeepsAPIStatus verifies apierrors helpers still see through the translation
+func TestTranslateErrorKeepsAPIStatus(t *testing.T) {
+	err := TranslateError("backup", "my-backup", apierrors.NewNotFound(schema.GroupResource{Resource: "nonadminbackups"}, "my-backup"))
+	if !apierrors.IsNotFound(err) {
+		t.Errorf("expected apierrors.IsNotFound to hold for %v", err)
+	}
+}
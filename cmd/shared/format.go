@@ -0,0 +1,60 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+// FormatBytes renders a byte count in binary units, e.g. "1.5 KiB"
+func FormatBytes(bytes int64) string {
+	if bytes < 0 {
+		// Negating math.MinInt64 wraps around, but its uint64 conversion is still the magnitude
+		return "-" + formatByteMagnitude(uint64(-bytes))
+	}
+	return formatByteMagnitude(uint64(bytes))
+}
+
+func formatByteMagnitude(bytes uint64) string {
+	const (
+		unit  = 1024
+		units = "KMGTPE"
+	)
+	// Also guards the log math below, which is -Inf for 0
+	if bytes < unit {
+		return fmt.Sprintf("%d B", bytes)
+	}
+	exp := int(math.Log(float64(bytes)) / math.Log(unit))
+	if exp > len(units) {
+		exp = len(units)
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(bytes)/math.Pow(unit, float64(exp)), units[exp-1])
+}
+
+// FormatAge renders the time elapsed since t the way kubectl does, e.g. "45s", "3h20m" or "2d".
+// Timestamps in the future (clock skew) are shown as "0s".
+func FormatAge(t time.Time) string {
+	age := time.Since(t)
+	if age < 0 {
+		age = 0
+	}
+	return duration.HumanDuration(age)
+}
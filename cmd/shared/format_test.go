@@ -0,0 +1,71 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestFormatBytes(t *testing.T) {
+	tests := []struct {
+		bytes int64
+		want  string
+	}{
+		{0, "0 B"},
+		{1, "1 B"},
+		{512, "512 B"},
+		{1023, "1023 B"},
+		{1024, "1.0 KiB"},
+		{1536, "1.5 KiB"},
+		{5 * 1024 * 1024, "5.0 MiB"},
+		{3 * 1024 * 1024 * 1024, "3.0 GiB"},
+		{2 * 1024 * 1024 * 1024 * 1024 * 1024, "2.0 PiB"},
+		{math.MaxInt64, "8.0 EiB"},
+		{-1536, "-1.5 KiB"},
+		{math.MinInt64, "-8.0 EiB"},
+	}
+
+	for _, tt := range tests {
+		if got := FormatBytes(tt.bytes); got != tt.want {
+			t.Errorf("FormatBytes(%d) = %q, want %q", tt.bytes, got, tt.want)
+		}
+	}
+}
+
+func TestFormatAge(t *testing.T) {
+	tests := []struct {
+		age  time.Duration
+		want string
+	}{
+		{0, "0s"},
+		{10 * time.Second, "10s"},
+		{time.Minute, "60s"},
+		{5 * time.Minute, "5m"},
+		{3*time.Hour + 20*time.Minute, "3h20m"},
+		{50 * time.Hour, "2d2h"},
+		{10 * 24 * time.Hour, "10d"},
+		{-time.Hour, "0s"},
+	}
+
+	for _, tt := range tests {
+		if got := FormatAge(time.Now().Add(-tt.age)); got != tt.want {
+			t.Errorf("FormatAge(now-%s) = %q, want %q", tt.age, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Impersonate is bound to the --as and --as-group flags; when set, clients built by
+// NewClientWithScheme act as that user, e.g. so admins can check non-admin RBAC
+var Impersonate rest.ImpersonationConfig
+
+// BindImpersonationFlags registers --as and --as-group on flags
+func BindImpersonationFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&Impersonate.UserName, "as", "", "Username to impersonate for the operation")
+	flags.StringArrayVar(&Impersonate.Groups, "as-group", nil, "Group to impersonate for the operation; repeat for multiple groups")
+}
+
+// impersonatedConfig returns a copy of config acting as impersonate
+func impersonatedConfig(config *rest.Config, impersonate rest.ImpersonationConfig) (*rest.Config, error) {
+	if impersonate.UserName == "" && len(impersonate.Groups) > 0 {
+		return nil, fmt.Errorf("--as-group requires --as")
+	}
+
+	config = rest.CopyConfig(config)
+	config.Impersonate = impersonate
+	return config, nil
+}
+
+// impersonating reports whether --as or --as-group is set
+func impersonating() bool {
+	return Impersonate.UserName != "" || len(Impersonate.Groups) > 0
+}
+
+// RestConfig returns the factory's rest config, acting as the --as user when set,
+// since the Velero factory has no impersonation support
+func RestConfig(f client.Factory) (*rest.Config, error) {
+	config, err := f.ClientConfig()
+	if err != nil || !impersonating() {
+		return config, err
+	}
+	return impersonatedConfig(config, Impersonate)
+}
+
+// newWatchClient returns the factory's client, or one acting as the --as user when
+// impersonation is requested
+func newWatchClient(f client.Factory) (kbclient.WithWatch, error) {
+	if !impersonating() {
+		return f.KubebuilderWatchClient()
+	}
+
+	config, err := RestConfig(f)
+	if err != nil {
+		return nil, err
+	}
+
+	// Match the types the factory's own client registers
+	scheme := runtime.NewScheme()
+	for _, add := range []func(*runtime.Scheme) error{clientgoscheme.AddToScheme, velerov1.AddToScheme, velerov2alpha1.AddToScheme} {
+		if err := add(scheme); err != nil {
+			return nil, err
+		}
+	}
+	return kbclient.NewWithWatch(config, kbclient.Options{Scheme: scheme})
+}
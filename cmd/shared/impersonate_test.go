@@ -0,0 +1,51 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/spf13/pflag"
+	"k8s.io/client-go/rest"
+)
+
+func TestImpersonationFlags(t *testing.T) {
+	t.Cleanup(func() { Impersonate = rest.ImpersonationConfig{} })
+
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	BindImpersonationFlags(flags)
+	if err := flags.Parse([]string{"--as", "alice", "--as-group", "developers", "--as-group", "testers"}); err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	base := &rest.Config{Host: "https://example.com:6443"}
+	config, err := impersonatedConfig(base, Impersonate)
+	if err != nil {
+		t.Fatalf("impersonatedConfig() error = %v", err)
+	}
+	if config.Impersonate.UserName != "alice" || !slices.Equal(config.Impersonate.Groups, []string{"developers", "testers"}) {
+		t.Errorf("expected to impersonate alice in developers and testers, got %+v", config.Impersonate)
+	}
+	if base.Impersonate.UserName != "" {
+		t.Error("expected the factory's config to be left unchanged")
+	}
+
+	if _, err := impersonatedConfig(base, rest.ImpersonationConfig{Groups: []string{"developers"}}); err == nil {
+		t.Error("expected an error for --as-group without --as")
+	}
+}
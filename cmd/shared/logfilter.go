@@ -0,0 +1,85 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+// LineFilter decides whether a log line is printed
+type LineFilter func(line string) bool
+
+// LogLineTime extracts the timestamp from a Velero (logrus text format) log line,
+// e.g. `time="2025-01-02T15:04:05Z" level=info msg="..."`
+func LogLineTime(line string) (time.Time, bool) {
+	const prefix = `time="`
+
+	start := strings.Index(line, prefix)
+	if start < 0 {
+		return time.Time{}, false
+	}
+	value := line[start+len(prefix):]
+	end := strings.IndexByte(value, '"')
+	if end < 0 {
+		return time.Time{}, false
+	}
+
+	t, err := time.Parse(time.RFC3339, value[:end])
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// SinceFilter keeps lines logged at or after cutoff. Lines without a parseable
+// timestamp (e.g. continuation lines) are kept.
+func SinceFilter(cutoff time.Time) LineFilter {
+	return func(line string) bool {
+		t, ok := LogLineTime(line)
+		return !ok || !t.Before(cutoff)
+	}
+}
+
+// MatchFilter keeps lines matching re
+func MatchFilter(re *regexp.Regexp) LineFilter {
+	return re.MatchString
+}
+
+// AllFilters keeps lines accepted by every non-nil filter. It returns nil, which
+// accepts every line, when there are no filters.
+func AllFilters(filters ...LineFilter) LineFilter {
+	var active []LineFilter
+	for _, filter := range filters {
+		if filter != nil {
+			active = append(active, filter)
+		}
+	}
+	if len(active) == 0 {
+		return nil
+	}
+
+	return func(line string) bool {
+		for _, filter := range active {
+			if !filter(line) {
+				return false
+			}
+		}
+		return true
+	}
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"bytes"
+	"compress/gzip"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSinceFilter(t *testing.T) {
+	cutoff := time.Date(2025, 1, 2, 15, 0, 0, 0, time.UTC)
+	filter := SinceFilter(cutoff)
+
+	tests := []struct {
+		line string
+		want bool
+	}{
+		{`time="2025-01-02T14:59:59Z" level=info msg="before the cutoff"`, false},
+		{`time="2025-01-02T15:00:00Z" level=info msg="at the cutoff"`, true},
+		{`time="2025-01-02T16:30:00+01:00" level=info msg="after the cutoff in another zone"`, true},
+		{`time="2025-01-02T14:30:00-01:00" level=error msg="after the cutoff behind UTC"`, true},
+		{`    continuation of a multi-line message`, true},
+		{`time="not a time" level=info msg="unparseable"`, true},
+	}
+
+	for _, tt := range tests {
+		if got := filter(tt.line); got != tt.want {
+			t.Errorf("SinceFilter(%s)(%q) = %v, want %v", cutoff.Format(time.RFC3339), tt.line, got, tt.want)
+		}
+	}
+}
+
+func TestStreamFilteredGzippedLines(t *testing.T) {
+	lines := []string{
+		`time="2025-01-02T14:00:00Z" level=info msg="old"`,
+		`time="2025-01-02T15:30:00Z" level=info msg="new"`,
+		`no timestamp`,
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gzw := gzip.NewWriter(w)
+		defer gzw.Close()
+		_, _ = gzw.Write([]byte(strings.Join(lines, "\n") + "\n"))
+	}))
+	defer server.Close()
+
+	var out bytes.Buffer
+	if err := StreamFilteredGzippedLines(server.Client(), server.URL, &out, SinceFilter(time.Date(2025, 1, 2, 15, 0, 0, 0, time.UTC))); err != nil {
+		t.Fatalf("StreamFilteredGzippedLines() error = %v", err)
+	}
+
+	if got, want := out.String(), lines[1]+"\n"+lines[2]+"\n"; got != want {
+		t.Errorf("unexpected output:\n%s\nwant:\n%s", got, want)
+	}
+}
@@ -25,6 +25,12 @@ import (
 	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
 )
 
+// Annotations recording the reason an admin gave when deciding on a NABSL request
+const (
+	NABSLApprovalReasonAnnotation  = "openshift.io/oadp-approval-reason"
+	NABSLRejectionReasonAnnotation = "openshift.io/oadp-rejection-reason"
+)
+
 // FindNABSLRequestByNameOrUUID finds a NonAdminBackupStorageLocationRequest by either:
 // 1. Direct UUID lookup (if nameOrUUID is the actual request UUID)
 // 2. NABSL name lookup (searches through all requests to find one with matching source NABSL name)
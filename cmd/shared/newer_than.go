@@ -0,0 +1,49 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"time"
+
+	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BindNewerThanFlag registers --newer-than on flags
+func BindNewerThanFlag(flags *pflag.FlagSet, window *time.Duration) {
+	flags.DurationVar(window, "newer-than", 0, "Only list items created within this duration, e.g. 24h (0 lists everything)")
+}
+
+// FilterNewerThan returns the items created less than window before now, in their
+// original order. A zero window keeps every item.
+func FilterNewerThan[T any, PT interface {
+	*T
+	GetCreationTimestamp() metav1.Time
+}](items []T, window time.Duration, now time.Time) []T {
+	if window <= 0 {
+		return items
+	}
+
+	cutoff := now.Add(-window)
+	filtered := make([]T, 0, len(items))
+	for i := range items {
+		if PT(&items[i]).GetCreationTimestamp().After(cutoff) {
+			filtered = append(filtered, items[i])
+		}
+	}
+	return filtered
+}
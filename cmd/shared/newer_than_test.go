@@ -0,0 +1,53 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestFilterNewerThan(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	newBackup := func(name string, age time.Duration) nacv1alpha1.NonAdminBackup {
+		return nacv1alpha1.NonAdminBackup{
+			ObjectMeta: metav1.ObjectMeta{Name: name, CreationTimestamp: metav1.NewTime(now.Add(-age))},
+		}
+	}
+	items := []nacv1alpha1.NonAdminBackup{
+		newBackup("two-hours", 2*time.Hour),
+		newBackup("ten-minutes", 10*time.Minute),
+		newBackup("yesterday", 24*time.Hour),
+		newBackup("just-now", 0),
+	}
+
+	var names []string
+	for _, item := range FilterNewerThan(items, time.Hour, now) {
+		names = append(names, item.Name)
+	}
+	if want := []string{"ten-minutes", "just-now"}; !slices.Equal(names, want) {
+		t.Errorf("FilterNewerThan(1h) = %v, want %v", names, want)
+	}
+
+	if got := FilterNewerThan(items, 0, now); len(got) != len(items) {
+		t.Errorf("expected a zero window to keep all %d items, got %d", len(items), len(got))
+	}
+}
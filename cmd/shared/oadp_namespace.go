@@ -0,0 +1,45 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"context"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// OADPOperatorDeployment is the name of the OADP operator deployment
+const OADPOperatorDeployment = "openshift-adp-controller-manager"
+
+// DetectOADPNamespace returns the namespace the OADP operator is installed in, found
+// by listing deployments in all namespaces. Commands that need it more than once detect
+// it once and pass the result along, rather than listing deployments again.
+func DetectOADPNamespace(ctx context.Context, kbClient kbclient.Client) (string, error) {
+	var deployments appsv1.DeploymentList
+	if err := kbClient.List(ctx, &deployments); err != nil {
+		return "", fmt.Errorf("failed to list deployments to detect the OADP namespace: %w", err)
+	}
+
+	for _, deployment := range deployments.Items {
+		if deployment.Name == OADPOperatorDeployment {
+			return deployment.Namespace, nil
+		}
+	}
+	return "", fmt.Errorf("OADP operator deployment %q not found", OADPOperatorDeployment)
+}
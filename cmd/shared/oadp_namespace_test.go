@@ -0,0 +1,79 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+// TestDetectOADPNamespace verifies the namespace is found through the operator deployment
+func TestDetectOADPNamespace(t *testing.T) {
+	app := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "my-app", Namespace: "my-project"}}
+	operator := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: OADPOperatorDeployment, Namespace: "openshift-adp"}}
+
+	tests := []struct {
+		name        string
+		objs        []kbclient.Object
+		listErr     error
+		expect      string
+		expectError string
+	}{
+		{name: "operator installed", objs: []kbclient.Object{app, operator}, expect: "openshift-adp"},
+		{name: "operator missing", objs: []kbclient.Object{app}, expectError: `OADP operator deployment "openshift-adp-controller-manager" not found`},
+		{name: "list forbidden", listErr: errors.New("forbidden"), expectError: "failed to list deployments to detect the OADP namespace: forbidden"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := fake.NewClientBuilder().
+				WithScheme(scheme.Scheme).
+				WithObjects(tt.objs...).
+				WithInterceptorFuncs(interceptor.Funcs{
+					List: func(ctx context.Context, c kbclient.WithWatch, list kbclient.ObjectList, opts ...kbclient.ListOption) error {
+						if tt.listErr != nil {
+							return tt.listErr
+						}
+						return c.List(ctx, list, opts...)
+					},
+				}).
+				Build()
+
+			namespace, err := DetectOADPNamespace(context.Background(), client)
+			if tt.expectError != "" {
+				if err == nil || err.Error() != tt.expectError {
+					t.Errorf("DetectOADPNamespace() error = %v, want %q", err, tt.expectError)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("DetectOADPNamespace() error = %v", err)
+			}
+			if namespace != tt.expect {
+				t.Errorf("DetectOADPNamespace() = %q, want %q", namespace, tt.expect)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// OutputFormatsAnnotation lists the -o values a command accepts, comma separated, when they
+// differ from Velero's table, json and yaml. A value ending in "=" accepts any -o value
+// with that prefix, e.g. custom-columns=.
+const OutputFormatsAnnotation = "oadp.openshift.io/output-formats"
+
+// veleroOutputFormats are the -o values accepted by Velero's output.ValidateFlags
+var veleroOutputFormats = []string{"table", "json", "yaml"}
+
+// AcceptsOutputFormat reports whether format is a valid -o value for c
+func AcceptsOutputFormat(c *cobra.Command, format string) bool {
+	formats := veleroOutputFormats
+	if annotation, ok := c.Annotations[OutputFormatsAnnotation]; ok {
+		formats = strings.Split(annotation, ",")
+	}
+
+	for _, accepted := range formats {
+		if format == accepted || (strings.HasSuffix(accepted, "=") && strings.HasPrefix(format, accepted)) {
+			return true
+		}
+	}
+	return false
+}
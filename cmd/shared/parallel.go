@@ -0,0 +1,52 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import "sync"
+
+// DefaultParallelism is the default number of concurrent workers for multi-object operations
+const DefaultParallelism = 4
+
+// ForEachParallel calls fn for every item using at most parallelism concurrent workers.
+// The returned errors are indexed like items, so callers can report results in input order.
+func ForEachParallel(items []string, parallelism int, fn func(string) error) []error {
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	errs := make([]error, len(items))
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < parallelism && w < len(items); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				errs[i] = fn(items[i])
+			}
+		}()
+	}
+
+	for i := range items {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	return errs
+}
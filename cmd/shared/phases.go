@@ -0,0 +1,98 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"fmt"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// IsBackupTerminal reports whether a Velero backup phase is final
+func IsBackupTerminal(phase velerov1.BackupPhase) bool {
+	switch phase {
+	case velerov1.BackupPhaseCompleted,
+		velerov1.BackupPhasePartiallyFailed,
+		velerov1.BackupPhaseFailed,
+		velerov1.BackupPhaseFailedValidation:
+		return true
+	}
+	return false
+}
+
+// IsRestoreTerminal reports whether a Velero restore phase is final
+func IsRestoreTerminal(phase velerov1.RestorePhase) bool {
+	switch phase {
+	case velerov1.RestorePhaseCompleted,
+		velerov1.RestorePhasePartiallyFailed,
+		velerov1.RestorePhaseFailed,
+		velerov1.RestorePhaseFailedValidation:
+		return true
+	}
+	return false
+}
+
+// VeleroBackupPhase returns the phase of the Velero backup behind a NonAdminBackup,
+// or "" if the controller hasn't created it yet
+func VeleroBackupPhase(nab *nacv1alpha1.NonAdminBackup) velerov1.BackupPhase {
+	if nab.Status.VeleroBackup == nil || nab.Status.VeleroBackup.Status == nil {
+		return ""
+	}
+	return nab.Status.VeleroBackup.Status.Phase
+}
+
+// IsNonAdminBackupTerminal reports whether a NonAdminBackup will make no further progress
+// on its own: its Velero backup finished, or the controller rejected it (BackingOff).
+// NonAdminBackup phases only track the request; Created means the Velero backup exists,
+// not that it is done.
+func IsNonAdminBackupTerminal(nab *nacv1alpha1.NonAdminBackup) bool {
+	if nab.Status.Phase == nacv1alpha1.NonAdminPhaseBackingOff {
+		return true
+	}
+	return IsBackupTerminal(VeleroBackupPhase(nab))
+}
+
+// NonAdminRejection returns the reason and message of an Accepted=False condition, which
+// the controller sets when it rejects a request, e.g. because admin enforcement forbids
+// part of its spec. rejected is false while the request is accepted or not yet processed.
+func NonAdminRejection(conditions []metav1.Condition) (reason string, rejected bool) {
+	accepted := meta.FindStatusCondition(conditions, string(nacv1alpha1.NonAdminConditionAccepted))
+	if accepted == nil || accepted.Status != metav1.ConditionFalse {
+		return "", false
+	}
+	if accepted.Message == "" {
+		return accepted.Reason, true
+	}
+	return fmt.Sprintf("%s: %s", accepted.Reason, accepted.Message), true
+}
+
+// NonAdminBackupStatus returns a display string for a NonAdminBackup: the Velero backup
+// phase once there is one, otherwise the NonAdminBackup phase
+func NonAdminBackupStatus(nab *nacv1alpha1.NonAdminBackup) string {
+	if nab.Status.Phase == nacv1alpha1.NonAdminPhaseCreated {
+		if phase := VeleroBackupPhase(nab); phase != "" {
+			return string(phase)
+		}
+	}
+	if nab.Status.Phase != "" {
+		return string(nab.Status.Phase)
+	}
+	return "Unknown"
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"testing"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+func TestIsBackupTerminal(t *testing.T) {
+	tests := map[velerov1.BackupPhase]bool{
+		"":                                   false,
+		velerov1.BackupPhaseNew:              false,
+		velerov1.BackupPhaseFailedValidation: true,
+		velerov1.BackupPhaseInProgress:       false,
+		velerov1.BackupPhaseWaitingForPluginOperations:                false,
+		velerov1.BackupPhaseWaitingForPluginOperationsPartiallyFailed: false,
+		velerov1.BackupPhaseFinalizing:                                false,
+		velerov1.BackupPhaseFinalizingPartiallyFailed:                 false,
+		velerov1.BackupPhaseCompleted:                                 true,
+		velerov1.BackupPhasePartiallyFailed:                           true,
+		velerov1.BackupPhaseFailed:                                    true,
+		velerov1.BackupPhaseDeleting:                                  false,
+	}
+
+	for phase, want := range tests {
+		if got := IsBackupTerminal(phase); got != want {
+			t.Errorf("IsBackupTerminal(%q) = %v, want %v", phase, got, want)
+		}
+	}
+}
+
+func TestIsRestoreTerminal(t *testing.T) {
+	tests := map[velerov1.RestorePhase]bool{
+		"":                                    false,
+		velerov1.RestorePhaseNew:              false,
+		velerov1.RestorePhaseFailedValidation: true,
+		velerov1.RestorePhaseInProgress:       false,
+		velerov1.RestorePhaseWaitingForPluginOperations:                false,
+		velerov1.RestorePhaseWaitingForPluginOperationsPartiallyFailed: false,
+		velerov1.RestorePhaseFinalizing:                                false,
+		velerov1.RestorePhaseFinalizingPartiallyFailed:                 false,
+		velerov1.RestorePhaseCompleted:                                 true,
+		velerov1.RestorePhasePartiallyFailed:                           true,
+		velerov1.RestorePhaseFailed:                                    true,
+	}
+
+	for phase, want := range tests {
+		if got := IsRestoreTerminal(phase); got != want {
+			t.Errorf("IsRestoreTerminal(%q) = %v, want %v", phase, got, want)
+		}
+	}
+}
+
+func TestNonAdminBackupPhases(t *testing.T) {
+	withVeleroPhase := func(phase nacv1alpha1.NonAdminPhase, veleroPhase velerov1.BackupPhase) *nacv1alpha1.NonAdminBackup {
+		nab := &nacv1alpha1.NonAdminBackup{Status: nacv1alpha1.NonAdminBackupStatus{Phase: phase}}
+		if veleroPhase != "" {
+			nab.Status.VeleroBackup = &nacv1alpha1.VeleroBackup{
+				Status: &velerov1.BackupStatus{Phase: veleroPhase},
+			}
+		}
+		return nab
+	}
+
+	tests := []struct {
+		name         string
+		nab          *nacv1alpha1.NonAdminBackup
+		wantTerminal bool
+		wantStatus   string
+	}{
+		{"no phase", withVeleroPhase("", ""), false, "Unknown"},
+		{"new", withVeleroPhase(nacv1alpha1.NonAdminPhaseNew, ""), false, "New"},
+		{"backing off", withVeleroPhase(nacv1alpha1.NonAdminPhaseBackingOff, ""), true, "BackingOff"},
+		{"created without velero status", withVeleroPhase(nacv1alpha1.NonAdminPhaseCreated, ""), false, "Created"},
+		{"created in progress", withVeleroPhase(nacv1alpha1.NonAdminPhaseCreated, velerov1.BackupPhaseInProgress), false, "InProgress"},
+		{"created completed", withVeleroPhase(nacv1alpha1.NonAdminPhaseCreated, velerov1.BackupPhaseCompleted), true, "Completed"},
+		{"created failed", withVeleroPhase(nacv1alpha1.NonAdminPhaseCreated, velerov1.BackupPhaseFailed), true, "Failed"},
+		{"deleting", withVeleroPhase(nacv1alpha1.NonAdminPhaseDeleting, velerov1.BackupPhaseCompleted), true, "Deleting"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsNonAdminBackupTerminal(tt.nab); got != tt.wantTerminal {
+				t.Errorf("IsNonAdminBackupTerminal() = %v, want %v", got, tt.wantTerminal)
+			}
+			if got := NonAdminBackupStatus(tt.nab); got != tt.wantStatus {
+				t.Errorf("NonAdminBackupStatus() = %q, want %q", got, tt.wantStatus)
+			}
+		})
+	}
+}
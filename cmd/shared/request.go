@@ -0,0 +1,45 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"context"
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+// DefaultRequestTimeout bounds the API calls of a single non-admin command
+const DefaultRequestTimeout = 30 * time.Second
+
+// RequestTimeout is bound to the --request-timeout flag; zero disables the timeout
+var RequestTimeout = DefaultRequestTimeout
+
+// BindRequestTimeoutFlag registers --request-timeout on flags
+func BindRequestTimeoutFlag(flags *pflag.FlagSet) {
+	flags.DurationVar(&RequestTimeout, "request-timeout", DefaultRequestTimeout,
+		"How long to wait for API requests before giving up, e.g. 1m. Zero means no timeout.")
+}
+
+// RequestContext returns a context for API calls that is cancelled once --request-timeout
+// elapses. Waits and watches keep their own timeouts and should not use it.
+func RequestContext() (context.Context, context.CancelFunc) {
+	if RequestTimeout <= 0 {
+		return context.WithCancel(context.Background())
+	}
+	return context.WithTimeout(context.Background(), RequestTimeout)
+}
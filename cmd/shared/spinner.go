@@ -0,0 +1,75 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"fmt"
+	"io"
+)
+
+// spinnerFrames are drawn in turn, one per Tick, in front of the status
+var spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+// Spinner shows progress while waiting. On a terminal it redraws a single line with a
+// spinner and the latest status in place; elsewhere, or with --no-color, each Tick
+// prints a dot so logs stay readable.
+type Spinner struct {
+	w      io.Writer
+	tty    bool
+	frame  int
+	status string
+}
+
+// NewSpinner returns a Spinner writing to w
+func NewSpinner(w io.Writer) *Spinner {
+	return newSpinner(w, !NoColor && isTerminal(w))
+}
+
+func newSpinner(w io.Writer, tty bool) *Spinner {
+	return &Spinner{w: w, tty: tty}
+}
+
+// Tick advances the spinner, e.g. from WaitOptions.OnProgress
+func (s *Spinner) Tick() {
+	if !s.tty {
+		fmt.Fprint(s.w, ".")
+		return
+	}
+	s.frame = (s.frame + 1) % len(spinnerFrames)
+	s.draw()
+}
+
+// SetStatus updates the status shown next to the spinner, e.g. from WaitOptions.OnStatus.
+// Plain output only shows dots, so the status is not printed there.
+func (s *Spinner) SetStatus(status string) {
+	if status == s.status {
+		return
+	}
+	s.status = status
+	if s.tty {
+		s.draw()
+	}
+}
+
+// draw rewrites the spinner line, clearing whatever was left of the previous one
+func (s *Spinner) draw() {
+	line := spinnerFrames[s.frame]
+	if s.status != "" {
+		line += " " + s.status
+	}
+	fmt.Fprintf(s.w, "\r%s\033[K", line)
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// TestSpinner verifies plain output falls back to dots while terminals redraw one line
+// with carriage returns
+func TestSpinner(t *testing.T) {
+	var plain bytes.Buffer
+	spinner := newSpinner(&plain, false)
+	spinner.SetStatus("Status: InProgress")
+	spinner.Tick()
+	spinner.Tick()
+	if plain.String() != ".." {
+		t.Errorf("expected plain output to be dots, got %q", plain.String())
+	}
+
+	var tty bytes.Buffer
+	spinner = newSpinner(&tty, true)
+	spinner.Tick()
+	spinner.SetStatus("Status: InProgress")
+	spinner.SetStatus("Status: InProgress")
+	spinner.Tick()
+	out := tty.String()
+	if strings.Contains(out, ".") || strings.Contains(out, "\n") {
+		t.Errorf("expected the spinner to stay on one line without dots, got %q", out)
+	}
+	if got := strings.Count(out, "\r"); got != 3 {
+		t.Errorf("expected 3 redraws, got %d in %q", got, out)
+	}
+	if !strings.HasSuffix(out, "\r"+spinnerFrames[2]+" Status: InProgress\033[K") {
+		t.Errorf("expected the last redraw to show the frame and status, got %q", out)
+	}
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"sort"
+	"strings"
+)
+
+// Levenshtein returns the edit distance between a and b: the fewest single-character
+// insertions, deletions and substitutions that turn one into the other
+func Levenshtein(a, b string) int {
+	s, t := []rune(a), []rune(b)
+	prev := make([]int, len(t)+1)
+	curr := make([]int, len(t)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(s); i++ {
+		curr[0] = i
+		for j := 1; j <= len(t); j++ {
+			cost := 1
+			if s[i-1] == t[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(t)]
+}
+
+// Suggestions returns the candidates within maxDistance edits of name, closest first,
+// for "did you mean" hints. name itself is never suggested.
+func Suggestions(name string, candidates []string, maxDistance int) []string {
+	distances := make(map[string]int)
+	for _, candidate := range candidates {
+		if candidate == name {
+			continue
+		}
+		if d := Levenshtein(name, candidate); d <= maxDistance {
+			distances[candidate] = d
+		}
+	}
+
+	suggestions := make([]string, 0, len(distances))
+	for candidate := range distances {
+		suggestions = append(suggestions, candidate)
+	}
+	sort.Slice(suggestions, func(i, j int) bool {
+		if distances[suggestions[i]] != distances[suggestions[j]] {
+			return distances[suggestions[i]] < distances[suggestions[j]]
+		}
+		return suggestions[i] < suggestions[j]
+	})
+	return suggestions
+}
+
+// DidYouMean formats suggestions as a hint like "did you mean 'a', 'b' or 'c'?", or
+// returns "" when there are none
+func DidYouMean(suggestions []string) string {
+	if len(suggestions) == 0 {
+		return ""
+	}
+
+	quoted := make([]string, len(suggestions))
+	for i, suggestion := range suggestions {
+		quoted[i] = "'" + suggestion + "'"
+	}
+	list := quoted[0]
+	if len(quoted) > 1 {
+		list = strings.Join(quoted[:len(quoted)-1], ", ") + " or " + quoted[len(quoted)-1]
+	}
+	return "did you mean " + list + "?"
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"backup", "backup", 0},
+		{"backup", "", 6},
+		{"deployment.apps", "deployments.apps", 1},
+		{"kitten", "sitting", 3},
+	}
+	for _, tt := range tests {
+		if got := Levenshtein(tt.a, tt.b); got != tt.want {
+			t.Errorf("Levenshtein(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestSuggestions(t *testing.T) {
+	candidates := []string{"nightly-2", "nightly", "weekly", "nightly-10", "nightly-1"}
+	got := Suggestions("nightly-1", candidates, 2)
+	if want := []string{"nightly-10", "nightly-2", "nightly"}; !slices.Equal(got, want) {
+		t.Errorf("Suggestions() = %v, want %v", got, want)
+	}
+}
+
+func TestDidYouMean(t *testing.T) {
+	tests := []struct {
+		suggestions []string
+		want        string
+	}{
+		{nil, ""},
+		{[]string{"a"}, "did you mean 'a'?"},
+		{[]string{"a", "b"}, "did you mean 'a' or 'b'?"},
+		{[]string{"a", "b", "c"}, "did you mean 'a', 'b' or 'c'?"},
+	}
+	for _, tt := range tests {
+		if got := DidYouMean(tt.suggestions); got != tt.want {
+			t.Errorf("DidYouMean(%v) = %q, want %q", tt.suggestions, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"io"
+	"os"
+
+	"golang.org/x/term"
+)
+
+// NoColor forces plain ASCII status markers and progress dots; it is bound to the root --no-color flag
+var NoColor bool
+
+// StatusSymbols are the markers printed in front of per-item results
+type StatusSymbols struct {
+	OK      string
+	Fail    string
+	Unknown string
+	// Info prefixes notes, including its trailing spacing; it is empty in plain output
+	Info string
+}
+
+var (
+	fancySymbols = StatusSymbols{OK: "✓", Fail: "❌", Unknown: "?", Info: "ℹ️  "}
+	plainSymbols = StatusSymbols{OK: "[OK]", Fail: "[FAIL]", Unknown: "[UNKNOWN]", Info: ""}
+)
+
+// Symbols returns the status markers to use when writing to w. Symbols and emoji are only
+// used on terminals, since they break in logs and on some consoles, and never with --no-color.
+func Symbols(w io.Writer) StatusSymbols {
+	return symbolsFor(!NoColor && isTerminal(w))
+}
+
+func symbolsFor(fancy bool) StatusSymbols {
+	if fancy {
+		return fancySymbols
+	}
+	return plainSymbols
+}
+
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	return ok && term.IsTerminal(int(f.Fd()))
+}
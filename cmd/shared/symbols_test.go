@@ -0,0 +1,42 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"bytes"
+	"testing"
+)
+
+// TestSymbols verifies ASCII markers are used with --no-color and when not writing to a terminal
+func TestSymbols(t *testing.T) {
+	if got := symbolsFor(true); got.OK != "✓" || got.Fail != "❌" {
+		t.Errorf("symbolsFor(true) = %+v, want symbols", got)
+	}
+
+	var buf bytes.Buffer
+	if got := Symbols(&buf); got != plainSymbols {
+		t.Errorf("Symbols(non-TTY) = %+v, want %+v", got, plainSymbols)
+	}
+
+	NoColor = true
+	t.Cleanup(func() { NoColor = false })
+	for _, got := range []StatusSymbols{Symbols(&buf), Symbols(nil)} {
+		if got.OK != "[OK]" || got.Fail != "[FAIL]" || got.Info != "" {
+			t.Errorf("Symbols() with --no-color = %+v, want ASCII markers", got)
+		}
+	}
+}
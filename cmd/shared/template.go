@@ -0,0 +1,68 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/template"
+
+	"github.com/spf13/cobra"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
+)
+
+const (
+	// GoTemplatePrefix is the --output value prefix selecting an inline Go template
+	GoTemplatePrefix = "go-template="
+	// GoTemplateFilePrefix is the --output value prefix selecting a Go template file
+	GoTemplateFilePrefix = "go-template-file="
+)
+
+// ParseGoTemplate parses the template selected by a go-template= or go-template-file=
+// --output value. It returns nil if format selects neither.
+func ParseGoTemplate(format string) (*template.Template, error) {
+	var text string
+	switch {
+	case strings.HasPrefix(format, GoTemplatePrefix):
+		text = strings.TrimPrefix(format, GoTemplatePrefix)
+	case strings.HasPrefix(format, GoTemplateFilePrefix):
+		path := strings.TrimPrefix(format, GoTemplateFilePrefix)
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read go-template file: %w", err)
+		}
+		text = string(data)
+	default:
+		return nil, nil
+	}
+
+	if strings.TrimSpace(text) == "" {
+		return nil, fmt.Errorf("go-template format specified but no template given")
+	}
+	tmpl, err := template.New("output").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse go-template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// GoTemplateFlag returns the template selected by the command's --output flag, or nil
+// if it doesn't select a Go template
+func GoTemplateFlag(c *cobra.Command) (*template.Template, error) {
+	return ParseGoTemplate(output.GetOutputFlagValue(c))
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestParseGoTemplate(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "phase.tmpl")
+	if err := os.WriteFile(file, []byte("{{.Phase}}"), 0600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		format      string
+		expectNil   bool
+		expectError string
+	}{
+		{name: "not a template", format: "json", expectNil: true},
+		{name: "inline", format: "go-template={{.Phase}}"},
+		{name: "file", format: GoTemplateFilePrefix + file},
+		{name: "missing file", format: "go-template-file=/does/not/exist", expectError: "failed to read go-template file"},
+		{name: "empty", format: "go-template=", expectError: "no template given"},
+		{name: "parse failure", format: "go-template={{.Phase", expectError: "failed to parse go-template"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl, err := ParseGoTemplate(tt.format)
+			if tt.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+					t.Errorf("ParseGoTemplate() error = %v, want it to contain %q", err, tt.expectError)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseGoTemplate() error = %v", err)
+			}
+			if (tmpl == nil) != tt.expectNil {
+				t.Errorf("expected nil template: %t, got %v", tt.expectNil, tmpl)
+			}
+		})
+	}
+}
@@ -19,9 +19,21 @@ package shared
 import (
 	"testing"
 
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
 	"github.com/migtools/oadp-cli/internal/testutil"
 )
 
+// UseFakeClient makes NewClientWithScheme and NewClientWithFullScheme return kbClient for
+// the rest of the test, so command Run functions can be tested against a fake client.
+// The client's scheme must already include the types the command uses.
+func UseFakeClient(t *testing.T, kbClient kbclient.WithWatch) {
+	t.Helper()
+
+	clientOverride = kbClient
+	t.Cleanup(func() { clientOverride = nil })
+}
+
 // TestClientConfigIntegrationPattern provides a reusable pattern for testing that commands
 // respect client configuration (like namespace settings). This avoids duplicating the
 // setup/teardown and common testing pattern across multiple test files.
@@ -0,0 +1,142 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"time"
+
+	"k8s.io/client-go/tools/cache"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+// ErrWaitInterrupted is returned by WaitForPhase when a signal arrives on WaitOptions.Interrupt
+var ErrWaitInterrupted = errors.New("wait interrupted")
+
+// WaitOptions configures WaitForPhase
+type WaitOptions struct {
+	// Interrupt, if set, stops the wait with ErrWaitInterrupted when a signal arrives,
+	// e.g. a channel registered with signal.Notify for os.Interrupt
+	Interrupt <-chan os.Signal
+	// ProgressInterval is how often OnProgress is called while waiting; 0 disables it
+	ProgressInterval time.Duration
+	// OnProgress, if set, is called every ProgressInterval, e.g. to print a dot
+	OnProgress func()
+	// OnStatus, if set, is called with the message isTerminal returns for each update
+	// that doesn't end the wait, e.g. to show the current phase
+	OnStatus func(status string)
+}
+
+// waitEvent is an informer notification about the watched object
+type waitEvent struct {
+	obj     any
+	deleted bool
+}
+
+// WaitForPhase watches the object namespace/name with a shared informer until isTerminal
+// reports it done, and returns the message isTerminal produced. list is the list type
+// used to list and watch objects of type T.
+//
+// It returns ctx.Err() if ctx is cancelled first, ErrWaitInterrupted on a signal from
+// opts.Interrupt (e.g. ctrl-c), and an error if the object is deleted while waiting.
+// The informer is stopped before returning.
+func WaitForPhase[T kbclient.Object](ctx context.Context, watchClient kbclient.WithWatch, list kbclient.ObjectList, namespace, name string, isTerminal func(T) (bool, string), opts WaitOptions) (string, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	events := make(chan waitEvent)
+	send := func(event waitEvent) {
+		// Don't block the informer once we've stopped listening
+		select {
+		case events <- event:
+		case <-ctx.Done():
+		}
+	}
+
+	var zero T
+	example := reflect.New(reflect.TypeOf(zero).Elem()).Interface().(T)
+
+	lw := kube.InternalLW{
+		Client:     watchClient,
+		Namespace:  namespace,
+		ObjectList: list,
+	}
+	informer := cache.NewSharedInformer(&lw, example, 0)
+	_, _ = informer.AddEventHandler(
+		cache.FilteringResourceEventHandler{
+			FilterFunc: func(obj any) bool {
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				o, ok := obj.(T)
+				return ok && o.GetName() == name
+			},
+			Handler: cache.ResourceEventHandlerFuncs{
+				AddFunc: func(obj any) {
+					send(waitEvent{obj: obj})
+				},
+				UpdateFunc: func(_, obj any) {
+					send(waitEvent{obj: obj})
+				},
+				DeleteFunc: func(obj any) {
+					send(waitEvent{obj: obj, deleted: true})
+				},
+			},
+		},
+	)
+
+	go informer.Run(ctx.Done())
+
+	var progress <-chan time.Time
+	if opts.ProgressInterval > 0 && opts.OnProgress != nil {
+		ticker := time.NewTicker(opts.ProgressInterval)
+		defer ticker.Stop()
+		progress = ticker.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-opts.Interrupt:
+			return "", ErrWaitInterrupted
+		case <-progress:
+			opts.OnProgress()
+		case event := <-events:
+			if event.deleted {
+				return "", fmt.Errorf("%q was deleted while waiting for it to complete", name)
+			}
+			obj, ok := event.obj.(T)
+			if !ok {
+				continue
+			}
+			done, msg := isTerminal(obj)
+			if done {
+				return msg, nil
+			}
+			if opts.OnStatus != nil {
+				opts.OnStatus(msg)
+			}
+		}
+	}
+}
@@ -0,0 +1,243 @@
+/*
+Copyright 2025 The OADP CLI Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+func newWaitFakeClient(t *testing.T, objs ...kbclient.Object) kbclient.WithWatch {
+	t.Helper()
+
+	scheme, err := NewSchemeWithTypes(ClientOptions{IncludeNonAdminTypes: true})
+	if err != nil {
+		t.Fatalf("Failed to create scheme: %v", err)
+	}
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+// updateAfter applies mutate to obj and updates it in the fake client after a short delay,
+// so the change arrives through the informer's watch rather than its initial list
+func updateAfter(t *testing.T, client kbclient.WithWatch, obj kbclient.Object, mutate func()) {
+	t.Helper()
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		mutate()
+		if err := client.Update(context.Background(), obj); err != nil {
+			t.Errorf("Failed to update %s: %v", obj.GetName(), err)
+		}
+	}()
+}
+
+func TestWaitForPhaseBackup(t *testing.T) {
+	nab := &nacv1alpha1.NonAdminBackup{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-backup", Namespace: "my-project"},
+		Status:     nacv1alpha1.NonAdminBackupStatus{Phase: nacv1alpha1.NonAdminPhaseCreated},
+	}
+	client := newWaitFakeClient(t, nab)
+
+	updateAfter(t, client, nab, func() {
+		nab.Status.VeleroBackup = &nacv1alpha1.VeleroBackup{
+			Status: &velerov1.BackupStatus{Phase: velerov1.BackupPhaseCompleted},
+		}
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	status, err := WaitForPhase(ctx, client, &nacv1alpha1.NonAdminBackupList{}, "my-project", "my-backup",
+		func(backup *nacv1alpha1.NonAdminBackup) (bool, string) {
+			return IsNonAdminBackupTerminal(backup), NonAdminBackupStatus(backup)
+		},
+		WaitOptions{},
+	)
+	if err != nil {
+		t.Fatalf("WaitForPhase() error = %v", err)
+	}
+	if status != string(velerov1.BackupPhaseCompleted) {
+		t.Errorf("WaitForPhase() = %q, want %q", status, velerov1.BackupPhaseCompleted)
+	}
+}
+
+func TestWaitForPhaseRestore(t *testing.T) {
+	nar := &nacv1alpha1.NonAdminRestore{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-restore", Namespace: "my-project"},
+		Status:     nacv1alpha1.NonAdminRestoreStatus{Phase: nacv1alpha1.NonAdminPhaseCreated},
+	}
+	// Another restore in the namespace must not end the wait
+	other := &nacv1alpha1.NonAdminRestore{
+		ObjectMeta: metav1.ObjectMeta{Name: "other-restore", Namespace: "my-project"},
+		Status: nacv1alpha1.NonAdminRestoreStatus{
+			VeleroRestore: &nacv1alpha1.VeleroRestore{
+				Status: &velerov1.RestoreStatus{Phase: velerov1.RestorePhaseFailed},
+			},
+		},
+	}
+	client := newWaitFakeClient(t, nar, other)
+
+	updateAfter(t, client, nar, func() {
+		nar.Status.VeleroRestore = &nacv1alpha1.VeleroRestore{
+			Status: &velerov1.RestoreStatus{Phase: velerov1.RestorePhasePartiallyFailed},
+		}
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	status, err := WaitForPhase(ctx, client, &nacv1alpha1.NonAdminRestoreList{}, "my-project", "my-restore",
+		func(restore *nacv1alpha1.NonAdminRestore) (bool, string) {
+			if restore.Status.VeleroRestore == nil || restore.Status.VeleroRestore.Status == nil {
+				return false, ""
+			}
+			phase := restore.Status.VeleroRestore.Status.Phase
+			return IsRestoreTerminal(phase), string(phase)
+		},
+		WaitOptions{},
+	)
+	if err != nil {
+		t.Fatalf("WaitForPhase() error = %v", err)
+	}
+	if status != string(velerov1.RestorePhasePartiallyFailed) {
+		t.Errorf("WaitForPhase() = %q, want %q", status, velerov1.RestorePhasePartiallyFailed)
+	}
+}
+
+func TestWaitForPhaseCancelled(t *testing.T) {
+	nab := &nacv1alpha1.NonAdminBackup{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-backup", Namespace: "my-project"},
+	}
+	client := newWaitFakeClient(t, nab)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	progress := 0
+	_, err := WaitForPhase(ctx, client, &nacv1alpha1.NonAdminBackupList{}, "my-project", "my-backup",
+		func(backup *nacv1alpha1.NonAdminBackup) (bool, string) {
+			return IsNonAdminBackupTerminal(backup), NonAdminBackupStatus(backup)
+		},
+		WaitOptions{ProgressInterval: 5 * time.Millisecond, OnProgress: func() { progress++ }},
+	)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("WaitForPhase() error = %v, want context.Canceled", err)
+	}
+	if progress == 0 {
+		t.Error("expected OnProgress to be called while waiting")
+	}
+}
+
+func TestWaitForPhaseDeleted(t *testing.T) {
+	nab := &nacv1alpha1.NonAdminBackup{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-backup", Namespace: "my-project"},
+	}
+	client := newWaitFakeClient(t, nab)
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		if err := client.Delete(context.Background(), nab); err != nil {
+			t.Errorf("Failed to delete backup: %v", err)
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := WaitForPhase(ctx, client, &nacv1alpha1.NonAdminBackupList{}, "my-project", "my-backup",
+		func(backup *nacv1alpha1.NonAdminBackup) (bool, string) {
+			return IsNonAdminBackupTerminal(backup), NonAdminBackupStatus(backup)
+		},
+		WaitOptions{},
+	)
+	if err == nil || !strings.Contains(err.Error(), "was deleted") {
+		t.Fatalf("WaitForPhase() error = %v, want deleted error", err)
+	}
+}
+
+// stopTrackingWatch records when the informer stops its watch
+type stopTrackingWatch struct {
+	watch.Interface
+	stopped chan struct{}
+	once    sync.Once
+}
+
+func (w *stopTrackingWatch) Stop() {
+	w.once.Do(func() { close(w.stopped) })
+	w.Interface.Stop()
+}
+
+func TestWaitForPhaseInterrupted(t *testing.T) {
+	nab := &nacv1alpha1.NonAdminBackup{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-backup", Namespace: "my-project"},
+	}
+
+	watches := make(chan *stopTrackingWatch, 10)
+	client := interceptor.NewClient(newWaitFakeClient(t, nab), interceptor.Funcs{
+		Watch: func(ctx context.Context, c kbclient.WithWatch, list kbclient.ObjectList, opts ...kbclient.ListOption) (watch.Interface, error) {
+			w, err := c.Watch(ctx, list, opts...)
+			if err != nil {
+				return nil, err
+			}
+			tracked := &stopTrackingWatch{Interface: w, stopped: make(chan struct{})}
+			watches <- tracked
+			return tracked, nil
+		},
+	})
+
+	interrupt := make(chan os.Signal, 1)
+	go func() {
+		// Deliver the signal once the informer is watching
+		time.Sleep(50 * time.Millisecond)
+		interrupt <- os.Interrupt
+	}()
+
+	_, err := WaitForPhase(context.Background(), client, &nacv1alpha1.NonAdminBackupList{}, "my-project", "my-backup",
+		func(backup *nacv1alpha1.NonAdminBackup) (bool, string) {
+			return IsNonAdminBackupTerminal(backup), NonAdminBackupStatus(backup)
+		},
+		WaitOptions{Interrupt: interrupt},
+	)
+	if !errors.Is(err, ErrWaitInterrupted) {
+		t.Fatalf("WaitForPhase() error = %v, want ErrWaitInterrupted", err)
+	}
+
+	select {
+	case w := <-watches:
+		select {
+		case <-w.stopped:
+		case <-time.After(5 * time.Second):
+			t.Error("expected the informer watch to be stopped after an interrupt")
+		}
+	default:
+		t.Error("expected the informer to have started a watch")
+	}
+}
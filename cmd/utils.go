@@ -30,6 +30,8 @@ import (
 type ClientConfig struct {
 	Namespace string `json:"namespace,omitempty"`
 	Features  string `json:"features,omitempty"`
+	// DefaultOutput is the -o default of the get commands that accept it; other commands ignore it
+	DefaultOutput string `json:"default-output,omitempty"`
 }
 
 // readVeleroClientConfig reads the Velero client configuration from ~/.config/velero/config.json